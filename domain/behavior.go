@@ -0,0 +1,124 @@
+package domain
+
+// Pipe behaviour settings
+const (
+	moveRange     = 3             // Rows a moving gap travels either side of where it spawned
+	moveEvery     = 4             // Scrolls per row a moving gap travels
+	closeFraction = 3             // A closing gap loses 1/closeFraction of its size
+	settleAhead   = 2 * PipeWidth // Behaviours settle this far ahead of the bird so it can react
+)
+
+// PipeKind identifies a pipe behaviour that difficulty settings can enable
+type PipeKind int
+
+const (
+	PipeMoving  PipeKind = iota // Gap travels up and down
+	PipeClosing                 // Gap shrinks as the pipe approaches
+	PipeSliding                 // Top pipe only, sliding down into place as it approaches
+)
+
+// PipeBehavior changes a pipe's gap as it scrolls
+// Behaviours keep their own state, so snapshots copy them with Clone
+type PipeBehavior interface {
+	Update(p *Pipe) // Called after every one-cell scroll
+	Clone() PipeBehavior
+}
+
+// newBehavior returns a behaviour of the given kind for a freshly spawned pipe and sets up its gap
+func newBehavior(kind PipeKind, p *Pipe, screenHeight int) PipeBehavior {
+	switch kind {
+	case PipeClosing:
+		return &closingGap{
+			from:    p.GapSize,
+			to:      p.GapSize - p.GapSize/closeFraction,
+			centre2: 2*p.GapY + p.GapSize,
+			spawnX:  p.X,
+		}
+	case PipeSliding:
+		b := &slidingPipe{target: p.GapY, floor: screenHeight, spawnX: p.X}
+		b.Update(p)
+		return b
+	default: // PipeMoving
+		maxGapY := screenHeight - p.GapSize - minPipeY
+		return &movingGap{
+			top:    max(p.GapY-moveRange, minPipeY),
+			bottom: min(p.GapY+moveRange, maxGapY-1),
+			dir:    1,
+		}
+	}
+}
+
+// settle returns a value that runs from `from` where the pipe spawned to `to` once it is
+// settleAhead of the bird, and stays there
+func settle(p *Pipe, spawnX, from, to int) int {
+	end := BirdStartX + settleAhead
+	if p.X <= end || spawnX <= end {
+		return to
+	}
+	return from + (to-from)*(spawnX-p.X)/(spawnX-end)
+}
+
+// movingGap moves the gap one row every few scrolls, turning round at the ends of its range
+type movingGap struct {
+	top, bottom int // Range of GapY
+	dir         int // 1 while moving down, -1 while moving up
+	count       int // Scrolls since the last move
+}
+
+// Update moves the gap every moveEvery scrolls
+func (b *movingGap) Update(p *Pipe) {
+	b.count++
+	if b.count < moveEvery {
+		return
+	}
+	b.count = 0
+
+	if p.GapY+b.dir < b.top || p.GapY+b.dir > b.bottom {
+		b.dir = -b.dir
+	}
+	p.GapY = min(max(p.GapY+b.dir, b.top), b.bottom)
+}
+
+// Clone returns a copy of the behaviour
+func (b *movingGap) Clone() PipeBehavior {
+	c := *b
+	return &c
+}
+
+// closingGap shrinks the gap around its centre as the pipe approaches
+type closingGap struct {
+	from, to int // Gap size where the pipe spawned and once it has settled
+	centre2  int // Twice the gap's centre row, so odd sizes stay centred
+	spawnX   int
+}
+
+// Update sets the gap size for the pipe's position
+func (b *closingGap) Update(p *Pipe) {
+	p.GapSize = settle(p, b.spawnX, b.from, b.to)
+	p.GapY = (b.centre2 - p.GapSize) / 2
+}
+
+// Clone returns a copy of the behaviour
+func (b *closingGap) Clone() PipeBehavior {
+	c := *b
+	return &c
+}
+
+// slidingPipe has no bottom pipe and lowers its top pipe from the ceiling as it approaches
+type slidingPipe struct {
+	target int // GapY once the pipe has settled
+	floor  int // Playfield height, where the gap ends
+	spawnX int
+}
+
+// Update lowers the top pipe for the pipe's position
+func (b *slidingPipe) Update(p *Pipe) {
+	p.GapY = settle(p, b.spawnX, 0, b.target)
+	p.GapSize = b.floor - p.GapY
+}
+
+// Clone returns a copy of the behaviour
+func (b *slidingPipe) Clone() PipeBehavior {
+	c := *b
+	return &c
+}
@@ -0,0 +1,113 @@
+package domain
+
+import "testing"
+
+func TestMovingGapRange(t *testing.T) {
+	// On a 24 row playfield a gap of 6 can start from row minPipeY to row 14
+	tests := []struct {
+		name       string
+		gapY       int
+		wantTop    int
+		wantBottom int
+	}{
+		{name: "middle", gapY: 9, wantTop: 9 - moveRange, wantBottom: 9 + moveRange},
+		{name: "near the ceiling", gapY: minPipeY + 1, wantTop: minPipeY, wantBottom: minPipeY + 1 + moveRange},
+		{name: "near the floor", gapY: 13, wantTop: 13 - moveRange, wantBottom: 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Pipe{X: 80, Width: PipeWidth, GapY: tt.gapY, GapSize: 6}
+			b := newBehavior(PipeMoving, p, 24)
+
+			top, bottom := p.GapY, p.GapY
+			for i := 0; i < 200; i++ {
+				p.X--
+				b.Update(p)
+				top, bottom = min(top, p.GapY), max(bottom, p.GapY)
+			}
+			if top != tt.wantTop || bottom != tt.wantBottom {
+				t.Errorf("gap moved between rows %d and %d, want %d and %d", top, bottom, tt.wantTop, tt.wantBottom)
+			}
+		})
+	}
+}
+
+func TestBehaviorsSettle(t *testing.T) {
+	tests := []struct {
+		kind        PipeKind
+		name        string
+		wantSpawn   [2]int // GapY and GapSize where the pipe spawned
+		wantSettled [2]int // GapY and GapSize once settleAhead of the bird
+	}{
+		{kind: PipeClosing, name: "closing", wantSpawn: [2]int{8, 6}, wantSettled: [2]int{9, 4}},
+		{kind: PipeSliding, name: "sliding", wantSpawn: [2]int{0, 24}, wantSettled: [2]int{8, 16}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Pipe{X: 80, Width: PipeWidth, GapY: 8, GapSize: 6}
+			b := newBehavior(tt.kind, p, 24)
+			b.Update(p)
+			if got := [2]int{p.GapY, p.GapSize}; got != tt.wantSpawn {
+				t.Errorf("gap %v where the pipe spawned, want %v", got, tt.wantSpawn)
+			}
+
+			for p.X > BirdStartX+settleAhead {
+				size := p.GapSize
+				p.X--
+				b.Update(p)
+				if p.GapSize > size {
+					t.Fatalf("gap grew from %d to %d at column %d", size, p.GapSize, p.X)
+				}
+			}
+			if got := [2]int{p.GapY, p.GapSize}; got != tt.wantSettled {
+				t.Errorf("gap %v settleAhead of the bird, want %v", got, tt.wantSettled)
+			}
+
+			// The gap stays put from there on
+			for p.X > 0 {
+				p.X--
+				b.Update(p)
+				if got := [2]int{p.GapY, p.GapSize}; got != tt.wantSettled {
+					t.Fatalf("gap %v at column %d, want it to stay %v", got, p.X, tt.wantSettled)
+				}
+			}
+		})
+	}
+}
+
+func TestBehaviorCloneIsIndependent(t *testing.T) {
+	for _, tt := range []struct {
+		kind PipeKind
+		name string
+	}{
+		{PipeMoving, "moving"},
+		{PipeClosing, "closing"},
+		{PipeSliding, "sliding"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Pipe{X: 80, Width: PipeWidth, GapY: 8, GapSize: 6}
+			b := newBehavior(tt.kind, p, 24)
+			for i := 0; i < 10; i++ {
+				p.X--
+				b.Update(p)
+			}
+
+			// Running the original on must leave the clone where it was copied
+			clone, q := b.Clone(), *p
+			for i := 0; i < 30; i++ {
+				p.X--
+				b.Update(p)
+			}
+			for i := 0; i < 30; i++ {
+				q.X--
+				clone.Update(&q)
+			}
+			if q.GapY != p.GapY || q.GapSize != p.GapSize {
+				t.Errorf("clone left the gap at row %d size %d, original at row %d size %d",
+					q.GapY, q.GapSize, p.GapY, p.GapSize)
+			}
+		})
+	}
+}
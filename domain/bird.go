@@ -1,8 +1,10 @@
 package domain
 
+// Physics per StepDuration step, tuned to match the former 45ms tick on Normal
+// (gravity 0.3 and jump -2.3 per 45ms tick)
 const (
-	gravity   = 0.3  // Reduced from 0.8 - gentler fall
-	jumpForce = -2.3 // Increased from -1.8 - more responsive jump
+	gravity   = 0.3 / 9  // Per step squared - three steps per former tick
+	jumpForce = -2.3 / 3 // Per step
 )
 
 // Bird represents the player character
@@ -10,6 +12,8 @@ type Bird struct {
 	X        int
 	Y        float64
 	Velocity float64
+	Drift    float64 // Sub-cell horizontal push from the wind
+	Sprite   Sprite  // Appearance and hitbox
 }
 
 // NewBird creates a new bird at the given position
@@ -18,17 +22,18 @@ func NewBird(x int, y int) *Bird {
 		X:        x,
 		Y:        float64(y),
 		Velocity: 0,
+		Sprite:   DefaultSprite,
 	}
 }
 
-// Jump makes the bird flap upward
-func (b *Bird) Jump() {
-	b.Velocity = jumpForce
+// Jump makes the bird flap away from where gravity pulls
+func (b *Bird) Jump(p Physics) {
+	b.Velocity = p.JumpForce
 }
 
 // Update applies gravity and updates position
-func (b *Bird) Update() {
-	b.Velocity += gravity
+func (b *Bird) Update(p Physics) {
+	b.Velocity += p.Gravity
 	b.Y += b.Velocity
 }
 
@@ -39,10 +44,5 @@ func (b *Bird) GetY() int {
 
 // GetSprite returns the bird's sprite based on velocity
 func (b *Bird) GetSprite() string {
-	if b.Velocity < 0 {
-		// Going up
-		return "^○"
-	}
-	// Going down
-	return "v○"
+	return b.Sprite.Glyphs(b.Velocity)
 }
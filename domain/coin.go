@@ -0,0 +1,47 @@
+package domain
+
+const coinsBetween = 3 // Coins placed on the path between two pipes
+
+// Coin is a collectible placed on the path from one gap to the next
+type Coin struct {
+	X, Y int
+}
+
+// placeCoins lines coins up between the gaps of two neighbouring pipes
+func (w *World) placeCoins(prev, next *Pipe) {
+	left, right := prev.X+prev.Width, next.X
+	fromY, toY := prev.GapY+prev.GapSize/2, next.GapY+next.GapSize/2
+	for i := 1; i <= coinsBetween; i++ {
+		w.Coins = append(w.Coins, Coin{
+			X: left + (right-left)*i/(coinsBetween+1),
+			Y: fromY + (toY-fromY)*i/(coinsBetween+1),
+		})
+	}
+}
+
+// scrollCoins moves every coin one cell left and drops the ones that left the screen
+func (w *World) scrollCoins() {
+	coins := w.Coins[:0]
+	for _, c := range w.Coins {
+		c.X--
+		if c.X >= 0 {
+			coins = append(coins, c)
+		}
+	}
+	w.Coins = coins
+}
+
+// collectCoins picks up every coin the bird touches
+func (w *World) collectCoins() {
+	box := w.birdBox()
+	coins := w.Coins[:0]
+	for _, c := range w.Coins {
+		cell := Box{Left: float64(c.X), Top: float64(c.Y), Right: float64(c.X + 1), Bottom: float64(c.Y + 1)}
+		if box.Overlaps(cell) {
+			w.CoinsCollected++
+			continue
+		}
+		coins = append(coins, c)
+	}
+	w.Coins = coins
+}
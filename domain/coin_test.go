@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlaceCoins(t *testing.T) {
+	tests := []struct {
+		name       string
+		prev, next *Pipe
+		want       []Coin
+	}{
+		{
+			name: "level gaps",
+			prev: &Pipe{X: 10, Width: 4, GapY: 8, GapSize: 6},
+			next: &Pipe{X: 30, Width: 4, GapY: 8, GapSize: 6},
+			want: []Coin{{X: 18, Y: 11}, {X: 22, Y: 11}, {X: 26, Y: 11}},
+		},
+		{
+			name: "climbing to a higher gap",
+			prev: &Pipe{X: 10, Width: 4, GapY: 12, GapSize: 6},
+			next: &Pipe{X: 30, Width: 4, GapY: 4, GapSize: 6},
+			want: []Coin{{X: 18, Y: 13}, {X: 22, Y: 11}, {X: 26, Y: 9}},
+		},
+		{
+			name: "dropping to a lower gap",
+			prev: &Pipe{X: 10, Width: 4, GapY: 4, GapSize: 4},
+			next: &Pipe{X: 30, Width: 4, GapY: 12, GapSize: 4},
+			want: []Coin{{X: 18, Y: 8}, {X: 22, Y: 10}, {X: 26, Y: 12}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &World{}
+			w.placeCoins(tt.prev, tt.next)
+			if !slices.Equal(w.Coins, tt.want) {
+				t.Errorf("coins = %v, want %v", w.Coins, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrollCoins(t *testing.T) {
+	w := &World{Coins: []Coin{{X: 0, Y: 5}, {X: 1, Y: 5}, {X: 40, Y: 5}}}
+	w.scrollCoins()
+
+	want := []Coin{{X: 0, Y: 5}, {X: 39, Y: 5}}
+	if !slices.Equal(w.Coins, want) {
+		t.Errorf("coins = %v, want %v", w.Coins, want)
+	}
+}
+
+func TestCollectCoins(t *testing.T) {
+	w := NewWorld(testConfig(ModeClassic, 1))
+	row := w.Bird.GetY()
+	w.Coins = []Coin{
+		{X: BirdStartX, Y: row},     // Under the bird
+		{X: BirdStartX + 1, Y: row}, // Under its wing
+		{X: BirdStartX + 2, Y: row}, // Just ahead
+		{X: BirdStartX, Y: row + 2}, // Below
+		{X: BirdStartX - 1, Y: row}, // Just behind
+	}
+	w.collectCoins()
+
+	if w.CoinsCollected != 2 {
+		t.Errorf("collected %d coins, want 2", w.CoinsCollected)
+	}
+	want := []Coin{{X: BirdStartX + 2, Y: row}, {X: BirdStartX, Y: row + 2}, {X: BirdStartX - 1, Y: row}}
+	if !slices.Equal(w.Coins, want) {
+		t.Errorf("coins left = %v, want %v", w.Coins, want)
+	}
+}
@@ -0,0 +1,89 @@
+package domain
+
+import "fmt"
+
+// Course defaults
+const (
+	DefaultCourseHeight = 24 // Playfield height of courses that don't set one
+	DefaultCourseEnd    = 20 // Distance from the last pipe to the finish line
+	minCourseHeight     = 10 // Smallest playfield the game can show
+)
+
+// Course is a handcrafted pipe sequence played instead of randomly generated pipes
+// Positions are measured from the bird's start, so a course plays the same at any terminal width
+type Course struct {
+	Name   string
+	Height int // Playfield height the gaps are laid out for
+	Pipes  []CoursePipe
+	End    int // Distance from the last pipe to the finish line
+}
+
+// CoursePipe is one pipe of a course
+type CoursePipe struct {
+	Spacing int // Distance from the previous pipe, or from the bird for the first pipe
+	GapY    int // Y position of the gap's top
+	GapSize int // Size of the gap
+	Width   int // Width in cells
+}
+
+// Validate checks that every pipe fits the playfield and follows the previous one
+func (c *Course) Validate() error {
+	if c.Height < minCourseHeight {
+		return fmt.Errorf("height %d is below the minimum of %d", c.Height, minCourseHeight)
+	}
+	if len(c.Pipes) == 0 {
+		return fmt.Errorf("course has no pipes")
+	}
+
+	for i, p := range c.Pipes {
+		switch {
+		case p.Width < 1:
+			return fmt.Errorf("pipe %d: width must be at least 1", i+1)
+		case p.GapSize < 1:
+			return fmt.Errorf("pipe %d: gap size must be at least 1", i+1)
+		case p.GapY < 0 || p.GapY+p.GapSize > c.Height:
+			return fmt.Errorf("pipe %d: gap %d-%d is outside the playfield", i+1, p.GapY, p.GapY+p.GapSize)
+		case i == 0 && p.Spacing < 2:
+			return fmt.Errorf("pipe 1: spacing must put it ahead of the bird")
+		case i > 0 && p.Spacing < c.Pipes[i-1].Width:
+			return fmt.Errorf("pipe %d: spacing %d overlaps the previous pipe", i+1, p.Spacing)
+		}
+	}
+
+	if last := c.Pipes[len(c.Pipes)-1]; c.End <= last.Width {
+		return fmt.Errorf("end spacing %d puts the finish line inside the last pipe", c.End)
+	}
+	return nil
+}
+
+// Length returns the distance from the bird's start to the finish line
+func (c *Course) Length() int {
+	return c.Position(len(c.Pipes))
+}
+
+// Position returns the distance of pipe i from the bird's start
+// Passing len(Pipes) returns the position of the finish line
+func (c *Course) Position(i int) int {
+	pos := 0
+	for _, p := range c.Pipes[:i] {
+		pos += p.Spacing
+	}
+	if i == len(c.Pipes) {
+		return pos + c.End
+	}
+	return pos + c.Pipes[i].Spacing
+}
+
+// Clone returns a copy of the course that can be changed independently
+func (c *Course) Clone() *Course {
+	clone := *c
+	clone.Pipes = append([]CoursePipe(nil), c.Pipes...)
+	return &clone
+}
+
+// From returns the part of the course starting at pipe i
+func (c *Course) From(i int) *Course {
+	from := c.Clone()
+	from.Pipes = from.Pipes[i:]
+	return from
+}
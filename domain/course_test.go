@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+// testCourse returns a valid course of three pipes with wide open gaps
+func testCourse() *Course {
+	return &Course{
+		Name:   "Test",
+		Height: DefaultCourseHeight,
+		Pipes: []CoursePipe{
+			{Spacing: 20, GapY: 6, GapSize: 12, Width: PipeWidth},
+			{Spacing: 15, GapY: 4, GapSize: 12, Width: PipeWidth},
+			{Spacing: 15, GapY: 8, GapSize: 12, Width: PipeWidth},
+		},
+		End: DefaultCourseEnd,
+	}
+}
+
+func TestCourseValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(c *Course)
+		wantErr string
+	}{
+		{name: "valid", change: func(c *Course) {}},
+		{name: "smallest playfield", change: func(c *Course) {
+			c.Height = minCourseHeight
+			for i := range c.Pipes {
+				c.Pipes[i].GapY, c.Pipes[i].GapSize = 2, 6
+			}
+		}},
+		{name: "playfield too small", change: func(c *Course) { c.Height = minCourseHeight - 1 }, wantErr: "below the minimum"},
+		{name: "no pipes", change: func(c *Course) { c.Pipes = nil }, wantErr: "no pipes"},
+		{name: "zero width", change: func(c *Course) { c.Pipes[1].Width = 0 }, wantErr: "pipe 2: width"},
+		{name: "closed gap", change: func(c *Course) { c.Pipes[1].GapSize = 0 }, wantErr: "pipe 2: gap size"},
+		{name: "gap above the playfield", change: func(c *Course) { c.Pipes[0].GapY = -1 }, wantErr: "pipe 1: gap -1-11"},
+		{name: "gap below the playfield", change: func(c *Course) { c.Pipes[2].GapY = 13 }, wantErr: "pipe 3: gap 13-25"},
+		{name: "gap down to the floor", change: func(c *Course) { c.Pipes[2].GapY = 12 }},
+		{name: "first pipe on the bird", change: func(c *Course) { c.Pipes[0].Spacing = 1 }, wantErr: "ahead of the bird"},
+		{name: "overlapping pipes", change: func(c *Course) { c.Pipes[1].Spacing = PipeWidth - 1 }, wantErr: "overlaps the previous pipe"},
+		{name: "touching pipes", change: func(c *Course) { c.Pipes[1].Spacing = PipeWidth }},
+		{name: "finish inside the last pipe", change: func(c *Course) { c.End = PipeWidth }, wantErr: "finish line inside"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testCourse()
+			tt.change(c)
+			err := c.Validate()
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("Validate() = %v, want no error", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("Validate() = %v, want an error about %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCoursePositions(t *testing.T) {
+	c := testCourse()
+	for i, want := range []int{20, 35, 50, 50 + DefaultCourseEnd} {
+		if got := c.Position(i); got != want {
+			t.Errorf("Position(%d) = %d, want %d", i, got, want)
+		}
+	}
+	if got := c.Length(); got != 50+DefaultCourseEnd {
+		t.Errorf("Length() = %d, want %d", got, 50+DefaultCourseEnd)
+	}
+}
+
+func TestCourseFinishLine(t *testing.T) {
+	cfg := testConfig(ModeClassic, 1)
+	cfg.Course = testCourse()
+	w := NewWorld(cfg)
+	w.Invincible = true
+
+	if got := w.FinishX(); got != BirdStartX+cfg.Course.Length() {
+		t.Errorf("finish line starts at column %d, want %d", got, BirdStartX+cfg.Course.Length())
+	}
+
+	var ev Events
+	for i := 0; i < 10000 && !w.Over; i++ {
+		if w.FinishX() <= w.Bird.X {
+			t.Fatalf("step %d: bird passed the finish line at column %d", i, w.FinishX())
+		}
+		ev = w.Step(Input{})
+	}
+
+	if !w.Cleared || !ev.Finished {
+		t.Fatalf("cleared = %v, finished = %v, want the course cleared", w.Cleared, ev.Finished)
+	}
+	if w.FinishX() != w.Bird.X {
+		t.Errorf("run ended with the finish line at column %d, want the bird's column %d", w.FinishX(), w.Bird.X)
+	}
+	if w.Score != len(cfg.Course.Pipes) {
+		t.Errorf("score %d at the finish, want every pipe passed", w.Score)
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"hash/fnv"
+	"time"
+)
+
+// Daily challenge settings, the same for everyone so results are comparable
+const (
+	DailyDifficulty = DifficultyNormal
+	DailyHitbox     = HitboxPrecise
+	DailyWidth      = 80 // Fixed playfield so everyone gets the same pipes
+	DailyHeight     = 24
+)
+
+// DailyDate returns the daily challenge key for a time, in UTC so everyone shares the same day
+func DailyDate(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// DailySeed returns the pipe seed for a daily challenge date
+func DailySeed(date string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte("daily:" + date))
+	// Keep seeds in the same range as NewSeed and avoid 0
+	return h.Sum64()%(1<<53-1) + 1
+}
@@ -12,12 +12,17 @@ const (
 )
 
 // DifficultySettings holds the parameters for each difficulty level
+// Speeds are the time it takes pipes to scroll one cell; physics always runs at StepDuration
 type DifficultySettings struct {
-	InitialSpeed   time.Duration // Initial game speed
+	InitialSpeed   time.Duration // Initial scroll speed
 	SpeedIncrement time.Duration // How much to speed up
 	ScoreInterval  int           // Score interval for speed increase
 	MinSpeed       time.Duration // Minimum (maximum) speed
 	PipeGap        int           // Gap between top and bottom pipes
+	BehaviorChance int           // Percent of pipes spawned with a behaviour
+	PipeKinds      []PipeKind    // Behaviours that can spawn
+	HazardEvery    int           // Gaps between pipes per moving hazard
+	HazardKinds    []HazardKind  // Hazards that can spawn
 }
 
 // GetSettings returns the settings for a difficulty level
@@ -30,6 +35,10 @@ func (d Difficulty) GetSettings() DifficultySettings {
 			ScoreInterval:  5,                     // Every 5 points
 			MinSpeed:       time.Millisecond * 30, // Not too fast
 			PipeGap:        15,                    // Wider gap
+			BehaviorChance: 20,                    // Occasional sliding pipes
+			PipeKinds:      []PipeKind{PipeSliding},
+			HazardEvery:    4, // A slow hazard now and then
+			HazardKinds:    []HazardKind{HazardDebris, HazardBall},
 		}
 	case DifficultyHard:
 		return DifficultySettings{
@@ -38,6 +47,10 @@ func (d Difficulty) GetSettings() DifficultySettings {
 			ScoreInterval:  2,                     // Every 2 points
 			MinSpeed:       time.Millisecond * 10, // Very fast
 			PipeGap:        9,                     // Narrower gap
+			BehaviorChance: 40,                    // Every behaviour, often
+			PipeKinds:      []PipeKind{PipeMoving, PipeClosing, PipeSliding},
+			HazardEvery:    2, // Every other gap
+			HazardKinds:    []HazardKind{HazardBird, HazardDebris, HazardBall},
 		}
 	default: // DifficultyNormal
 		return DifficultySettings{
@@ -46,6 +59,10 @@ func (d Difficulty) GetSettings() DifficultySettings {
 			ScoreInterval:  3,
 			MinSpeed:       time.Millisecond * 20,
 			PipeGap:        12,
+			BehaviorChance: 30,
+			PipeKinds:      []PipeKind{PipeMoving, PipeSliding},
+			HazardEvery:    3,
+			HazardKinds:    []HazardKind{HazardBird, HazardDebris, HazardBall},
 		}
 	}
 }
@@ -61,3 +78,15 @@ func (d Difficulty) String() string {
 		return "Normal"
 	}
 }
+
+// ParseDifficulty returns the difficulty for a name produced by String
+func ParseDifficulty(s string) Difficulty {
+	switch s {
+	case "Easy":
+		return DifficultyEasy
+	case "Hard":
+		return DifficultyHard
+	default:
+		return DifficultyNormal
+	}
+}
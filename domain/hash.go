@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+// Hash returns a checksum of the simulated state
+// Worlds stepped from the same config with the same inputs hash the same, so two machines
+// running a match can compare hashes to catch a desync
+func (w *World) Hash() uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	put := func(values ...uint64) {
+		for _, v := range values {
+			binary.LittleEndian.PutUint64(buf[:], v)
+			h.Write(buf[:])
+		}
+	}
+	putInt := func(values ...int) {
+		for _, v := range values {
+			put(uint64(v))
+		}
+	}
+	putBool := func(b bool) {
+		if b {
+			put(1)
+		} else {
+			put(0)
+		}
+	}
+
+	putInt(w.Tick, w.Score, w.Distance, w.Crashes, w.CoinsCollected, int(w.GameSpeed))
+	putBool(w.Over)
+	putBool(w.Cleared)
+
+	// Floats are hashed bit for bit, so even the smallest drift shows up
+	putInt(w.Bird.X)
+	put(math.Float64bits(w.Bird.Y), math.Float64bits(w.Bird.Velocity), math.Float64bits(w.Bird.Drift))
+
+	for _, p := range w.Pipes {
+		putInt(p.X, p.Width, p.GapY, p.GapSize)
+		putBool(p.Passed)
+		putBool(p.Item != nil)
+	}
+	for _, hz := range w.Hazards {
+		x, y, _ := hz.Glyph()
+		putInt(x, y)
+	}
+	for _, c := range w.Coins {
+		putInt(c.X, c.Y)
+	}
+	for _, z := range w.Zones {
+		putInt(z.X, z.Width, int(z.Kind))
+	}
+	if w.Power != nil {
+		putInt(int(w.Power.Kind), w.Power.Steps)
+	}
+
+	return h.Sum64()
+}
@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"math/rand/v2"
+)
+
+// Hazard settings
+const (
+	hazardMargin    = 0.2         // Cells trimmed from each side of a hazard's box so grazes don't count
+	debrisGravity   = gravity / 2 // Debris falls slower than the bird
+	maxDebrisSpeed  = 0.5         // Fastest fall of debris, in cells per step
+	ballSpeed       = 0.15        // Vertical speed of bouncing balls, in cells per step
+	debrisLead      = 20          // Debris falls at least this far ahead of the bird
+	flyingBirdSpeed = 0.05        // Slowest flying bird, in cells per step on top of the scroll
+)
+
+// HazardKind identifies a moving hazard that difficulty settings can enable
+type HazardKind int
+
+const (
+	HazardBird   HazardKind = iota // Flies left faster than the pipes scroll
+	HazardDebris                   // Falls from the top
+	HazardBall                     // Bounces between the ceiling and the floor
+)
+
+// Hazard is a moving obstacle between the pipes
+// Each kind moves and collides in its own way, so the world handles them all alike
+type Hazard interface {
+	Step(w *World)             // Moves the hazard by one physics step
+	Scroll()                   // Moves the hazard one cell left with the pipes
+	CollidesWith(box Box) bool // Checks if a collision box touches the hazard
+	IsGone(w *World) bool      // Checks if the hazard has left the playfield
+	Glyph() (x, y int, glyph string)
+	Clone() Hazard
+}
+
+// NewHazard creates a hazard of one of the given kinds at a random spot for the playfield size
+func (g *PipeGenerator) NewHazard(kinds []HazardKind, screenWidth, screenHeight int) Hazard {
+	r := rand.New(&g.hazards)
+	y := r.IntN(screenHeight-2*minPipeY) + minPipeY
+
+	switch kinds[r.IntN(len(kinds))] {
+	case HazardDebris:
+		x := BirdStartX + debrisLead + r.IntN(max(screenWidth-BirdStartX-debrisLead, 1))
+		return &Debris{X: x, Y: -1}
+	case HazardBall:
+		velocity := ballSpeed
+		if r.IntN(2) == 0 {
+			velocity = -velocity
+		}
+		return &Ball{X: screenWidth, Y: float64(y), Velocity: velocity}
+	default: // HazardBird
+		return &FlyingBird{X: float64(screenWidth), Y: y, Speed: flyingBirdSpeed * float64(1+r.IntN(3))}
+	}
+}
+
+// cellBox returns the collision box of a hazard covering width cells from x, y
+func cellBox(x, y float64, width int) Box {
+	return Box{
+		Left:   x + hazardMargin,
+		Top:    y + hazardMargin,
+		Right:  x + float64(width) - hazardMargin,
+		Bottom: y + 1 - hazardMargin,
+	}
+}
+
+// FlyingBird flies straight to the left, faster than the pipes scroll
+type FlyingBird struct {
+	X     float64
+	Y     int
+	Speed float64 // Cells per step on top of the scroll
+}
+
+// Step flies the bird further left
+func (b *FlyingBird) Step(w *World) {
+	b.X -= b.Speed
+}
+
+// Scroll moves the bird with the pipes
+func (b *FlyingBird) Scroll() {
+	b.X--
+}
+
+// CollidesWith checks if a box touches the bird's two cells
+func (b *FlyingBird) CollidesWith(box Box) bool {
+	return box.Overlaps(cellBox(b.X, float64(b.Y), 2))
+}
+
+// IsGone checks if the bird has flown off the left edge
+func (b *FlyingBird) IsGone(w *World) bool {
+	return b.X+2 < 0
+}
+
+// Glyph returns where the bird is drawn, facing left with its wing flapping as it flies
+func (b *FlyingBird) Glyph() (x, y int, glyph string) {
+	glyph = "<v"
+	if int(b.X)%2 == 0 {
+		glyph = "<^"
+	}
+	return int(b.X), b.Y, glyph
+}
+
+// Clone returns a copy of the bird
+func (b *FlyingBird) Clone() Hazard {
+	c := *b
+	return &c
+}
+
+// Debris falls from the top of the playfield, speeding up as it goes
+type Debris struct {
+	X        int
+	Y        float64
+	Velocity float64
+}
+
+// Step lets the debris fall
+func (d *Debris) Step(w *World) {
+	d.Velocity = min(d.Velocity+debrisGravity, maxDebrisSpeed)
+	d.Y += d.Velocity
+}
+
+// Scroll moves the debris with the pipes
+func (d *Debris) Scroll() {
+	d.X--
+}
+
+// CollidesWith checks if a box touches the debris
+func (d *Debris) CollidesWith(box Box) bool {
+	return box.Overlaps(cellBox(float64(d.X), d.Y, 1))
+}
+
+// IsGone checks if the debris has hit the floor or scrolled off the left edge
+func (d *Debris) IsGone(w *World) bool {
+	return d.Y >= float64(w.Height) || d.X < 0
+}
+
+// Glyph returns where the debris is drawn
+func (d *Debris) Glyph() (x, y int, glyph string) {
+	return d.X, int(d.Y), "▼"
+}
+
+// Clone returns a copy of the debris
+func (d *Debris) Clone() Hazard {
+	c := *d
+	return &c
+}
+
+// Ball bounces between the ceiling and the floor at a steady speed
+type Ball struct {
+	X        int
+	Y        float64
+	Velocity float64 // Rows per step, negative while rising
+}
+
+// Step moves the ball, turning it round at the ceiling and the floor
+func (b *Ball) Step(w *World) {
+	b.Y += b.Velocity
+	if b.Y < 0 {
+		b.Y = -b.Y
+		b.Velocity = -b.Velocity
+	} else if bottom := float64(w.Height - 1); b.Y > bottom {
+		b.Y = 2*bottom - b.Y
+		b.Velocity = -b.Velocity
+	}
+}
+
+// Scroll moves the ball with the pipes
+func (b *Ball) Scroll() {
+	b.X--
+}
+
+// CollidesWith checks if a box touches the ball
+func (b *Ball) CollidesWith(box Box) bool {
+	return box.Overlaps(cellBox(float64(b.X), b.Y, 1))
+}
+
+// IsGone checks if the ball has scrolled off the left edge
+func (b *Ball) IsGone(w *World) bool {
+	return b.X < 0
+}
+
+// Glyph returns where the ball is drawn
+func (b *Ball) Glyph() (x, y int, glyph string) {
+	return b.X, int(b.Y + 0.5), "◉"
+}
+
+// Clone returns a copy of the ball
+func (b *Ball) Clone() Hazard {
+	c := *b
+	return &c
+}
+
+// spawnHazards adds a hazard halfway between two pipes on the difficulty's schedule
+func (w *World) spawnHazards(settings DifficultySettings) {
+	if w.Course != nil || !w.Mode.HasHazards() || settings.HazardEvery == 0 || len(w.Pipes) == 0 {
+		return
+	}
+	if w.Pipes[len(w.Pipes)-1].X != w.Width-pipeSpawnGap/2 {
+		return
+	}
+
+	w.gapsSinceHazard++
+	if w.gapsSinceHazard < settings.HazardEvery {
+		return
+	}
+	w.gapsSinceHazard = 0
+	w.Hazards = append(w.Hazards, w.pipeGen.NewHazard(settings.HazardKinds, w.Width, w.Height))
+}
+
+// stepHazards moves every hazard by one physics step and drops the ones that left the playfield
+func (w *World) stepHazards() {
+	hazards := w.Hazards[:0]
+	for _, h := range w.Hazards {
+		h.Step(w)
+		if !h.IsGone(w) {
+			hazards = append(hazards, h)
+		}
+	}
+	w.Hazards = hazards
+}
+
+// scrollHazards moves every hazard one cell left with the pipes
+func (w *World) scrollHazards() {
+	for _, h := range w.Hazards {
+		h.Scroll()
+	}
+}
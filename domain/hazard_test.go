@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBallBounces(t *testing.T) {
+	// The ball moves between row 0 and row 23 on a 24 row playfield
+	tests := []struct {
+		name         string
+		y            float64
+		velocity     float64
+		wantY        float64
+		wantVelocity float64
+	}{
+		{name: "rising", y: 10, velocity: -ballSpeed, wantY: 10 - ballSpeed, wantVelocity: -ballSpeed},
+		{name: "falling", y: 10, velocity: ballSpeed, wantY: 10 + ballSpeed, wantVelocity: ballSpeed},
+		{name: "off the ceiling", y: 0.1, velocity: -ballSpeed, wantY: ballSpeed - 0.1, wantVelocity: ballSpeed},
+		{name: "off the floor", y: 22.9, velocity: ballSpeed, wantY: 46 - 22.9 - ballSpeed, wantVelocity: -ballSpeed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld(testConfig(ModeClassic, 1))
+			b := &Ball{X: 40, Y: tt.y, Velocity: tt.velocity}
+			b.Step(w)
+
+			if math.Abs(b.Y-tt.wantY) > 1e-9 {
+				t.Errorf("ball at row %v, want %v", b.Y, tt.wantY)
+			}
+			if b.Velocity != tt.wantVelocity {
+				t.Errorf("velocity %v, want %v", b.Velocity, tt.wantVelocity)
+			}
+		})
+	}
+}
+
+func TestDebrisTerminalSpeed(t *testing.T) {
+	cfg := testConfig(ModeClassic, 1)
+	cfg.Height = 1000
+	w := NewWorld(cfg)
+	d := &Debris{X: 40, Y: -1}
+
+	for i := 0; i < 100; i++ {
+		before := d.Velocity
+		d.Step(w)
+		if d.Velocity > maxDebrisSpeed {
+			t.Fatalf("step %d: debris falls at %v, faster than %v", i, d.Velocity, maxDebrisSpeed)
+		}
+		if d.Velocity < before {
+			t.Fatalf("step %d: debris slowed from %v to %v", i, before, d.Velocity)
+		}
+	}
+	if d.Velocity != maxDebrisSpeed {
+		t.Errorf("debris falls at %v after 100 steps, want %v", d.Velocity, maxDebrisSpeed)
+	}
+}
+
+func TestHazardCollisionMargins(t *testing.T) {
+	// Every hazard covers row 5 from column 10; the flying bird covers two columns
+	hazards := map[string]Hazard{
+		"flying bird": &FlyingBird{X: 10, Y: 5},
+		"debris":      &Debris{X: 10, Y: 5},
+		"ball":        &Ball{X: 10, Y: 5},
+	}
+
+	tests := []struct {
+		name string
+		box  Box
+		want bool
+	}{
+		{name: "head on", box: Box{Left: 10, Top: 5, Right: 11, Bottom: 6}, want: true},
+		{name: "grazing from the left", box: Box{Left: 9, Top: 5, Right: 10 + hazardMargin, Bottom: 6}},
+		{name: "into the left side", box: Box{Left: 9, Top: 5, Right: 10 + hazardMargin + 0.1, Bottom: 6}, want: true},
+		{name: "grazing from above", box: Box{Left: 10, Top: 4, Right: 11, Bottom: 5 + hazardMargin}},
+		{name: "into the top", box: Box{Left: 10, Top: 4, Right: 11, Bottom: 5 + hazardMargin + 0.1}, want: true},
+		{name: "grazing from below", box: Box{Left: 10, Top: 6 - hazardMargin, Right: 11, Bottom: 7}},
+		{name: "into the bottom", box: Box{Left: 10, Top: 6 - hazardMargin - 0.1, Right: 11, Bottom: 7}, want: true},
+		{name: "a row above", box: Box{Left: 10, Top: 3, Right: 11, Bottom: 4}},
+	}
+
+	for name, h := range hazards {
+		for _, tt := range tests {
+			t.Run(name+" "+tt.name, func(t *testing.T) {
+				if got := h.CollidesWith(tt.box); got != tt.want {
+					t.Errorf("collides = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestHazardsDroppedWhenGone(t *testing.T) {
+	tests := []struct {
+		name     string
+		hazard   Hazard
+		wantGone bool
+	}{
+		{name: "flying bird on screen", hazard: &FlyingBird{X: 0, Y: 5, Speed: flyingBirdSpeed}},
+		{name: "flying bird's tail on screen", hazard: &FlyingBird{X: -1.9, Y: 5, Speed: 0.05}},
+		{name: "flying bird off the left edge", hazard: &FlyingBird{X: -1.9, Y: 5, Speed: 0.2}, wantGone: true},
+		{name: "debris above the floor", hazard: &Debris{X: 40, Y: 22}},
+		{name: "debris on the floor", hazard: &Debris{X: 40, Y: 23.9, Velocity: maxDebrisSpeed}, wantGone: true},
+		{name: "debris off the left edge", hazard: &Debris{X: -1, Y: 5}, wantGone: true},
+		{name: "ball at the left edge", hazard: &Ball{X: 0, Y: 5, Velocity: ballSpeed}},
+		{name: "ball off the left edge", hazard: &Ball{X: -1, Y: 5, Velocity: ballSpeed}, wantGone: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld(testConfig(ModeClassic, 1))
+			w.Hazards = []Hazard{tt.hazard}
+			w.stepHazards()
+
+			if gone := len(w.Hazards) == 0; gone != tt.wantGone {
+				t.Errorf("gone = %v, want %v", gone, tt.wantGone)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package domain
+
+import "math"
+
+// HitboxMode selects how precisely collisions are checked
+type HitboxMode int
+
+const (
+	HitboxPrecise   HitboxMode = iota // Sub-cell hitbox of the bird sprite
+	HitboxForgiving                   // Precise hitbox shrunk by forgivingMargin
+	HitboxClassic                     // Whole-cell check of the original game
+)
+
+const (
+	forgivingMargin = 0.2 // Cells trimmed from each side in forgiving mode
+	shrinkHeight    = 0.5 // Share of the hitbox's height kept by a shrunk bird
+)
+
+// Hitbox is a rectangle relative to the bird's position, in cells
+type Hitbox struct {
+	Left   float64
+	Top    float64
+	Right  float64
+	Bottom float64
+}
+
+// Box is a rectangle in world coordinates, in cells
+// Left/Top are inclusive, Right/Bottom exclusive
+type Box struct {
+	Left   float64
+	Top    float64
+	Right  float64
+	Bottom float64
+}
+
+// Overlaps checks if two boxes intersect
+func (b Box) Overlaps(o Box) bool {
+	return b.Left < o.Right && b.Right > o.Left && b.Top < o.Bottom && b.Bottom > o.Top
+}
+
+// Sprite is a bird appearance with its own hitbox
+type Sprite struct {
+	Name   string
+	Up     string // Glyphs while rising
+	Down   string // Glyphs while falling
+	Price  int    // Coins needed to unlock it in the shop, 0 if always available
+	Hitbox Hitbox
+}
+
+// DefaultSprite is the original bird, 2 cells wide with the wing on the left
+var DefaultSprite = Sprite{
+	Name:   "Classic",
+	Up:     "^○",
+	Down:   "v○",
+	Hitbox: Hitbox{Left: 0.3, Top: 0.15, Right: 2, Bottom: 0.85},
+}
+
+// Box returns the bird's collision box for a hitbox mode
+func (b *Bird) Box(mode HitboxMode) Box {
+	x := float64(b.X)
+	if mode == HitboxClassic {
+		// Whole cells: both sprite columns and the truncated row
+		y := float64(b.GetY())
+		return Box{Left: x, Top: y, Right: x + 2, Bottom: y + 1}
+	}
+
+	h := b.Sprite.Hitbox
+	box := Box{Left: x + h.Left, Top: b.Y + h.Top, Right: x + h.Right, Bottom: b.Y + h.Bottom}
+	if mode == HitboxForgiving {
+		box.Left += forgivingMargin
+		box.Top += forgivingMargin
+		box.Right -= forgivingMargin
+		box.Bottom -= forgivingMargin
+	}
+	return box
+}
+
+// ShrunkBox returns the collision box of a shrunk bird
+// The mode's box is cut down to the sprite's head, the only part still drawn, and to shrinkHeight
+// of its height, so shrinking always makes gaps easier whatever the mode
+func (b *Bird) ShrunkBox(mode HitboxMode) Box {
+	box := b.Box(mode)
+	head := float64(b.X) + 1
+	box.Left = max(box.Left, head)
+	box.Right = min(box.Right, head+1)
+
+	trim := (box.Bottom - box.Top) * (1 - shrinkHeight) / 2
+	box.Top += trim
+	box.Bottom -= trim
+	return box
+}
+
+// Row returns the screen row the bird is drawn on for a hitbox mode
+func (b *Bird) Row(mode HitboxMode) int {
+	if mode == HitboxClassic {
+		return b.GetY()
+	}
+	// Draw on the row holding the sprite's centre so it matches the sub-cell hitbox
+	return int(math.Floor(b.Y + 0.5))
+}
+
+// String returns the string representation of the hitbox mode
+func (h HitboxMode) String() string {
+	switch h {
+	case HitboxForgiving:
+		return "Forgiving"
+	case HitboxClassic:
+		return "Classic"
+	default:
+		return "Precise"
+	}
+}
+
+// Next returns the next hitbox mode in rotation
+func (h HitboxMode) Next() HitboxMode {
+	return (h + 1) % 3
+}
+
+// ParseHitboxMode returns the hitbox mode for a name produced by String
+// Scores recorded before hitbox modes existed have no name and used the classic check
+func ParseHitboxMode(s string) HitboxMode {
+	switch s {
+	case "Precise":
+		return HitboxPrecise
+	case "Forgiving":
+		return HitboxForgiving
+	default:
+		return HitboxClassic
+	}
+}
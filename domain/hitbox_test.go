@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPipeCollisionPerHitboxMode(t *testing.T) {
+	// The gap covers rows 10 to 14, and the bird's column starts at the pipe's left edge
+	pipe := &Pipe{X: BirdStartX, Width: PipeWidth, GapY: 10, GapSize: 5}
+
+	tests := []struct {
+		name          string
+		y             float64
+		pipeX         int
+		wantPrecise   bool
+		wantForgiving bool
+		wantClassic   bool
+	}{
+		{name: "inside the gap", y: 10, wantPrecise: false, wantForgiving: false, wantClassic: false},
+		{name: "row above the gap only in classic", y: 9.9, wantPrecise: false, wantForgiving: false, wantClassic: true},
+		{name: "grazing the top pipe", y: 9.8, wantPrecise: true, wantForgiving: false, wantClassic: true},
+		{name: "grazing the bottom pipe", y: 14.2, wantPrecise: true, wantForgiving: false, wantClassic: false},
+		{name: "into the bottom pipe", y: 14.4, wantPrecise: true, wantForgiving: true, wantClassic: false},
+		{name: "well into the top pipe", y: 5, wantPrecise: true, wantForgiving: true, wantClassic: true},
+		{name: "just before the pipe", y: 5, pipeX: BirdStartX + 2, wantPrecise: false, wantForgiving: false, wantClassic: false},
+		{name: "behind the wing", y: 5, pipeX: BirdStartX - PipeWidth, wantPrecise: false, wantForgiving: false, wantClassic: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := *pipe
+			if tt.pipeX != 0 {
+				p.X = tt.pipeX
+			}
+			bird := NewBird(BirdStartX, 0)
+			bird.Y = tt.y
+
+			for mode, want := range map[HitboxMode]bool{
+				HitboxPrecise:   tt.wantPrecise,
+				HitboxForgiving: tt.wantForgiving,
+				HitboxClassic:   tt.wantClassic,
+			} {
+				if got := p.CollidesWith(bird.Box(mode)); got != want {
+					t.Errorf("%s: collides = %v, want %v (box %+v)", mode, got, want, bird.Box(mode))
+				}
+			}
+		})
+	}
+}
+
+func TestBoxShrinksWithMode(t *testing.T) {
+	// On a whole row, each mode's box fits inside the one before it
+	bird := NewBird(BirdStartX, 12)
+	classic, precise, forgiving := bird.Box(HitboxClassic), bird.Box(HitboxPrecise), bird.Box(HitboxForgiving)
+	if !contains(classic, precise) || !contains(precise, forgiving) {
+		t.Errorf("boxes don't nest: classic %+v, precise %+v, forgiving %+v", classic, precise, forgiving)
+	}
+}
+
+func TestRowFollowsHitbox(t *testing.T) {
+	tests := []struct {
+		y       float64
+		mode    HitboxMode
+		wantRow int
+	}{
+		{y: 7.4, mode: HitboxPrecise, wantRow: 7},
+		{y: 7.6, mode: HitboxPrecise, wantRow: 8},
+		{y: 7.6, mode: HitboxClassic, wantRow: 7},
+	}
+
+	for _, tt := range tests {
+		bird := NewBird(BirdStartX, 0)
+		bird.Y = tt.y
+		if got := bird.Row(tt.mode); got != tt.wantRow {
+			t.Errorf("Row(%s) at %.1f = %d, want %d", tt.mode, tt.y, got, tt.wantRow)
+		}
+	}
+}
+
+// contains reports whether inner lies entirely within outer
+func contains(outer, inner Box) bool {
+	return inner.Left >= outer.Left && inner.Top >= outer.Top && inner.Right <= outer.Right && inner.Bottom <= outer.Bottom
+}
+
+func TestShrunkBoxIsSmaller(t *testing.T) {
+	for _, mode := range []HitboxMode{HitboxPrecise, HitboxForgiving, HitboxClassic} {
+		t.Run(mode.String(), func(t *testing.T) {
+			bird := NewBird(BirdStartX, 12)
+			bird.Y = 12.3
+			box, shrunk := bird.Box(mode), bird.ShrunkBox(mode)
+
+			if !contains(box, shrunk) {
+				t.Errorf("shrunk box %+v sticks out of %+v", shrunk, box)
+			}
+			if got, want := shrunk.Bottom-shrunk.Top, (box.Bottom-box.Top)*shrinkHeight; math.Abs(got-want) > 1e-9 {
+				t.Errorf("shrunk height %.3f, want %.3f", got, want)
+			}
+			if shrunk.Left < float64(bird.X+1) {
+				t.Errorf("shrunk box %+v reaches the wing column", shrunk)
+			}
+		})
+	}
+}
+
+func TestShrinkClearsGrazedPipes(t *testing.T) {
+	// The bird's box grazes the top pipe by a sliver its shrunk box no longer reaches
+	// Classic boxes snap to whole rows, so they can't graze a pipe
+	pipe := &Pipe{X: BirdStartX, Width: PipeWidth, GapY: 10, GapSize: 5}
+	for _, mode := range []HitboxMode{HitboxPrecise, HitboxForgiving} {
+		t.Run(mode.String(), func(t *testing.T) {
+			bird := NewBird(BirdStartX, 10)
+			bird.Y += float64(pipe.GapY) - 0.05 - bird.Box(mode).Top
+
+			if !pipe.CollidesWith(bird.Box(mode)) {
+				t.Fatalf("box %+v doesn't graze the pipe", bird.Box(mode))
+			}
+			if pipe.CollidesWith(bird.ShrunkBox(mode)) {
+				t.Errorf("shrunk box %+v still hits the pipe", bird.ShrunkBox(mode))
+			}
+		})
+	}
+}
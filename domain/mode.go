@@ -0,0 +1,122 @@
+package domain
+
+import "time"
+
+// Mode represents the kind of run being played
+type Mode int
+
+const (
+	ModeClassic    Mode = iota // Ranked run that ends on the first collision
+	ModePractice               // Unranked run with rewind, checkpoints and invincibility
+	ModeTimeAttack             // Most pipes before the time limit, collisions cost time
+	ModeSpeedrun               // Fastest time to pass a target number of pipes
+	ModeZen                    // Unranked run at a constant speed with gently drifting gaps
+	ModeVersus                 // Two players on identical pipes, the last bird flying wins
+)
+
+const modeCount = 6
+
+// Time attack settings
+const (
+	TimeAttackLimit   = 60 * time.Second // Length of a time attack run
+	TimeAttackPenalty = 5 * time.Second  // Time lost on every collision
+)
+
+// Speedrun settings
+const SplitInterval = 10 // Pipes between split times
+
+// SpeedrunTargets are the selectable numbers of pipes to pass in a speedrun
+var SpeedrunTargets = []int{25, 50, 100}
+
+// NextSpeedrunTarget returns the target after t in rotation
+func NextSpeedrunTarget(t int) int {
+	for i, target := range SpeedrunTargets {
+		if target == t {
+			return SpeedrunTargets[(i+1)%len(SpeedrunTargets)]
+		}
+	}
+	return SpeedrunTargets[0]
+}
+
+// Ranked reports whether runs in this mode are saved to the rankings
+func (m Mode) Ranked() bool {
+	return m != ModePractice && m != ModeZen && m != ModeVersus
+}
+
+// Accelerates reports whether pipes scroll faster as the score increases
+func (m Mode) Accelerates() bool {
+	return m != ModeZen
+}
+
+// HasHazards reports whether moving hazards and physics zones appear between the pipes
+func (m Mode) HasHazards() bool {
+	return m != ModeZen
+}
+
+// DriftingGaps reports whether each gap is placed close to the previous one
+func (m Mode) DriftingGaps() bool {
+	return m == ModeZen
+}
+
+// Respawns reports whether a collision puts the bird back at the last checkpoint
+// instead of ending the run
+func (m Mode) Respawns() bool {
+	return m == ModePractice || m == ModeTimeAttack || m == ModeSpeedrun
+}
+
+// TimeLimit returns how long a run lasts, or 0 if it only ends on a collision
+func (m Mode) TimeLimit() time.Duration {
+	if m == ModeTimeAttack {
+		return TimeAttackLimit
+	}
+	return 0
+}
+
+// CrashPenalty returns the time a collision costs
+func (m Mode) CrashPenalty() time.Duration {
+	if m == ModeTimeAttack {
+		return TimeAttackPenalty
+	}
+	return 0
+}
+
+// String returns the string representation of the mode
+func (m Mode) String() string {
+	switch m {
+	case ModePractice:
+		return "Practice"
+	case ModeTimeAttack:
+		return "Time Attack"
+	case ModeSpeedrun:
+		return "Speedrun"
+	case ModeZen:
+		return "Zen"
+	case ModeVersus:
+		return "Versus"
+	default:
+		return "Classic"
+	}
+}
+
+// Next returns the next mode in rotation
+func (m Mode) Next() Mode {
+	return (m + 1) % modeCount
+}
+
+// ParseMode returns the mode for a name produced by String
+func ParseMode(s string) Mode {
+	switch s {
+	case "Practice":
+		return ModePractice
+	case "Time Attack":
+		return ModeTimeAttack
+	case "Speedrun":
+		return ModeSpeedrun
+	case "Zen":
+		return ModeZen
+	case "Versus":
+		return ModeVersus
+	default:
+		return ModeClassic
+	}
+}
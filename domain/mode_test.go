@@ -0,0 +1,90 @@
+package domain
+
+import "testing"
+
+func TestTimeAttackLimit(t *testing.T) {
+	limit := int(TimeAttackLimit / StepDuration)
+	penalty := int(TimeAttackPenalty / StepDuration)
+
+	tests := []struct {
+		name       string
+		invincible bool
+	}{
+		{name: "without crashes", invincible: true},
+		{name: "with crashes", invincible: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld(testConfig(ModeTimeAttack, 1))
+			w.Invincible = tt.invincible
+
+			// Without flapping, a vulnerable bird keeps hitting the floor and respawning
+			var ev Events
+			for i := 0; i < 2*limit && !w.Over; i++ {
+				ev = w.Step(Input{})
+			}
+
+			if !w.Over || !ev.Finished || ev.Died {
+				t.Fatalf("run ended with %+v, want it finished by the clock", ev)
+			}
+			if w.Penalty != w.Crashes*penalty {
+				t.Errorf("Penalty = %d steps for %d crashes, want %d", w.Penalty, w.Crashes, w.Crashes*penalty)
+			}
+			// The run ends on the first step the clock, penalties included, runs out
+			if used := w.Tick + w.Penalty; used < limit || used-1 >= limit+penalty {
+				t.Errorf("ended at step %d with %d penalty steps, limit is %d", w.Tick, w.Penalty, limit)
+			}
+			if w.TimeLeft() != 0 {
+				t.Errorf("TimeLeft = %v at the end", w.TimeLeft())
+			}
+			if tt.invincible != (w.Crashes == 0) {
+				t.Errorf("Crashes = %d", w.Crashes)
+			}
+		})
+	}
+}
+
+func TestTimeAttackScoreSurvivesRespawns(t *testing.T) {
+	w := NewWorld(testConfig(ModeTimeAttack, 1))
+	w.Invincible = true
+	for w.Score < 2 {
+		w.Step(autopilot(w))
+	}
+
+	// A crash puts the bird back after the last passed pipe, keeping the points
+	w.Invincible = false
+	for !w.Step(Input{}).Respawned {
+	}
+	if w.Score != 2 {
+		t.Errorf("Score = %d after respawning, want 2", w.Score)
+	}
+}
+
+func TestSpeedrunSplits(t *testing.T) {
+	cfg := testConfig(ModeSpeedrun, 1)
+	cfg.Target = 25
+	w := NewWorld(cfg)
+	w.Invincible = true
+
+	var ev Events
+	for i := 0; i < 20000 && !w.Over; i++ {
+		ev = w.Step(autopilot(w))
+	}
+
+	if !w.Over || !ev.Finished || w.Score != cfg.Target {
+		t.Fatalf("run ended with %d pts and %+v, want it finished at %d", w.Score, ev, cfg.Target)
+	}
+	// A split every SplitInterval pipes, and one at the target
+	if len(w.Splits) != 3 {
+		t.Fatalf("splits = %v, want 3 (10, 20 and 25 pipes)", w.Splits)
+	}
+	for i := 1; i < len(w.Splits); i++ {
+		if w.Splits[i] <= w.Splits[i-1] {
+			t.Errorf("splits aren't increasing: %v", w.Splits)
+		}
+	}
+	if last := w.Splits[len(w.Splits)-1]; last != w.Elapsed() {
+		t.Errorf("final split %v, run took %v", last, w.Elapsed())
+	}
+}
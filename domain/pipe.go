@@ -1,66 +1,123 @@
 package domain
 
 import (
+	"math"
 	"math/rand/v2"
 )
 
 const (
 	PipeWidth = 8 // Width of pipes - doubled for thicker dokan
 	minPipeY  = 3 // Minimum gap from top
+	maxDrift  = 2 // Largest move of a drifting gap from the previous one
 )
 
 // Pipe represents an obstacle
 type Pipe struct {
-	X       int
-	GapY    int // Y position of the gap's top
-	GapSize int // Size of the gap
-	Passed  bool
+	X        int
+	Width    int // Width in cells
+	GapY     int // Y position of the gap's top
+	GapSize  int // Size of the gap
+	Passed   bool
+	Behavior PipeBehavior // Changes the gap as the pipe scrolls, nil for a static pipe
+	Item     *Item        // Power-up in the gap, nil if there is none or it was collected
+}
+
+// PipeGenerator creates pipes from a seeded random source so a run can be reproduced
+type PipeGenerator struct {
+	src       rand.PCG // Held by value so copying the generator copies its state
+	behaviors rand.PCG // Separate stream so behaviours don't change where gaps are placed
+	items     rand.PCG // Separate stream so power-ups don't change the pipes
+	hazards   rand.PCG // Separate stream so hazards don't change the pipes
+	zones     rand.PCG // Separate stream so zones don't change the pipes
+}
+
+// NewPipeGenerator creates a pipe generator for the given seed
+func NewPipeGenerator(seed uint64) *PipeGenerator {
+	return &PipeGenerator{
+		src:       *rand.NewPCG(seed, seed),
+		behaviors: *rand.NewPCG(seed, ^seed),
+		items:     *rand.NewPCG(^seed, seed),
+		hazards:   *rand.NewPCG(^seed, ^seed),
+		zones:     *rand.NewPCG(seed+1, seed),
+	}
+}
+
+// Clone returns a generator that continues with the same sequence independently
+func (g *PipeGenerator) Clone() *PipeGenerator {
+	return &PipeGenerator{src: g.src, behaviors: g.behaviors, items: g.items, hazards: g.hazards, zones: g.zones}
+}
+
+// NewSeed returns a random seed for runs started without an explicit one
+func NewSeed() uint64 {
+	// Avoid 0 so a seed is always shown as set
+	return rand.Uint64N(1<<53-1) + 1
 }
 
 // NewPipe creates a new pipe at the right edge of the screen
-func NewPipe(screenWidth, screenHeight, gapSize int) *Pipe {
+func (g *PipeGenerator) NewPipe(screenWidth, screenHeight, gapSize int) *Pipe {
 	// Random gap position, ensuring gap fits within screen
 	maxGapY := screenHeight - gapSize - minPipeY
-	gapY := rand.IntN(maxGapY-minPipeY) + minPipeY
+	gapY := rand.New(&g.src).IntN(maxGapY-minPipeY) + minPipeY
 
 	return &Pipe{
 		X:       screenWidth,
+		Width:   PipeWidth,
 		GapY:    gapY,
 		GapSize: gapSize,
 		Passed:  false,
 	}
 }
 
-// Update moves the pipe to the left
+// DriftPipe creates a new pipe whose gap lies close to the previous pipe's gap
+func (g *PipeGenerator) DriftPipe(prev *Pipe, screenWidth, screenHeight, gapSize int) *Pipe {
+	// Move the gap a little, keeping it within the same range as NewPipe
+	maxGapY := screenHeight - gapSize - minPipeY
+	gapY := prev.GapY + rand.New(&g.src).IntN(2*maxDrift+1) - maxDrift
+	gapY = min(max(gapY, minPipeY), maxGapY-1)
+
+	return &Pipe{
+		X:       screenWidth,
+		Width:   PipeWidth,
+		GapY:    gapY,
+		GapSize: gapSize,
+		Passed:  false,
+	}
+}
+
+// AddBehavior gives the pipe one of the difficulty's behaviours, or leaves it static
+func (g *PipeGenerator) AddBehavior(p *Pipe, settings DifficultySettings, screenHeight int) {
+	r := rand.New(&g.behaviors)
+	if len(settings.PipeKinds) == 0 || r.IntN(100) >= settings.BehaviorChance {
+		return
+	}
+	kind := settings.PipeKinds[r.IntN(len(settings.PipeKinds))]
+	p.Behavior = newBehavior(kind, p, screenHeight)
+}
+
+// Update moves the pipe to the left and lets its behaviour change the gap
 func (p *Pipe) Update() {
 	p.X--
+	if p.Behavior != nil {
+		p.Behavior.Update(p)
+	}
 }
 
 // IsOffScreen checks if the pipe has moved off the left edge
 func (p *Pipe) IsOffScreen() bool {
-	return p.X+PipeWidth < 0
+	return p.X+p.Width < 0
 }
 
-// CollidesWith checks if the bird collides with this pipe
-func (p *Pipe) CollidesWith(bird *Bird) bool {
-	birdY := bird.GetY()
-
-	// Bird is 2 characters wide (mO or wO), check both positions
-	for birdX := bird.X; birdX < bird.X+2; birdX++ {
-		// Check if bird is horizontally aligned with pipe
-		if birdX >= p.X && birdX < p.X+PipeWidth {
-			// Check if bird is outside the gap
-			if birdY < p.GapY || birdY >= p.GapY+p.GapSize {
-				return true
-			}
-		}
-	}
-
-	return false
+// CollidesWith checks if a collision box overlaps this pipe
+func (p *Pipe) CollidesWith(box Box) bool {
+	left := float64(p.X)
+	right := float64(p.X + p.Width)
+	top := Box{Left: left, Top: math.Inf(-1), Right: right, Bottom: float64(p.GapY)}
+	bottom := Box{Left: left, Top: float64(p.GapY + p.GapSize), Right: right, Bottom: math.Inf(1)}
+	return box.Overlaps(top) || box.Overlaps(bottom)
 }
 
 // IsPassed checks if the bird has passed this pipe
 func (p *Pipe) IsPassed(bird *Bird) bool {
 	// Bird is 2 characters wide, check the right edge (bird.x+1)
-	return bird.X+1 > p.X+PipeWidth && !p.Passed
+	return bird.X+1 > p.X+p.Width && !p.Passed
 }
@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Power-up settings
+const (
+	itemChance     = 25              // Percent of pipes carrying a power-up item
+	slowMoDuration = 5 * time.Second // How long slow-motion lasts
+	slowMoFactor   = 2               // How many times longer pipes take to scroll in slow-motion
+	shrinkDuration = 5 * time.Second // How long the bird stays shrunk
+)
+
+// PowerUpKind identifies a power-up
+type PowerUpKind int
+
+const (
+	PowerShield PowerUpKind = iota // Absorbs one pipe collision
+	PowerSlowMo                    // Pipes scroll slower for a while
+	PowerShrink                    // The bird has a smaller hitbox for a while
+)
+
+const powerUpKinds = 3
+
+// String returns the string representation of the power-up
+func (k PowerUpKind) String() string {
+	switch k {
+	case PowerSlowMo:
+		return "Slow-mo"
+	case PowerShrink:
+		return "Shrink"
+	default:
+		return "Shield"
+	}
+}
+
+// duration returns how long the power-up lasts, 0 if it lasts until used
+func (k PowerUpKind) duration() time.Duration {
+	switch k {
+	case PowerSlowMo:
+		return slowMoDuration
+	case PowerShrink:
+		return shrinkDuration
+	default:
+		return 0
+	}
+}
+
+// Item is a power-up waiting to be collected in a pipe's gap
+type Item struct {
+	Kind PowerUpKind
+}
+
+// PowerUp is the power-up the bird is using
+type PowerUp struct {
+	Kind  PowerUpKind
+	Steps int // Steps left, 0 for a shield that lasts until it is hit
+}
+
+// TimeLeft returns how long the power-up still lasts
+func (p *PowerUp) TimeLeft() time.Duration {
+	return time.Duration(p.Steps) * StepDuration
+}
+
+// ItemPos returns the cell of the pipe's item, in the middle of its gap
+func (p *Pipe) ItemPos() (x, y int) {
+	return p.X + p.Width/2, p.GapY + p.GapSize/2
+}
+
+// AddItem puts a random power-up in some of the pipes' gaps
+func (g *PipeGenerator) AddItem(p *Pipe) {
+	r := rand.New(&g.items)
+	if r.IntN(100) >= itemChance {
+		return
+	}
+	p.Item = &Item{Kind: PowerUpKind(r.IntN(powerUpKinds))}
+}
+
+// collectItems picks up any item the bird touches and makes it the active power-up
+func (w *World) collectItems(ev *Events) {
+	box := w.birdBox()
+	for _, p := range w.Pipes {
+		if p.Item == nil {
+			continue
+		}
+		x, y := p.ItemPos()
+		cell := Box{Left: float64(x), Top: float64(y), Right: float64(x + 1), Bottom: float64(y + 1)}
+		if !box.Overlaps(cell) {
+			continue
+		}
+
+		w.Power = &PowerUp{Kind: p.Item.Kind, Steps: int(p.Item.Kind.duration() / StepDuration)}
+		p.Item = nil
+		ev.PoweredUp = true
+	}
+}
+
+// wearOff counts down the active power-up and drops it once it runs out
+func (w *World) wearOff() {
+	if w.Power == nil || w.Power.Steps == 0 {
+		return
+	}
+	w.Power.Steps--
+	if w.Power.Steps == 0 {
+		w.Power = nil
+	}
+}
+
+// shielded reports whether a pipe collision is absorbed
+// A shield is used up by the first hit that would crash the bird and protects it until it is clear of the pipe
+func (w *World) shielded() bool {
+	if w.Shielding {
+		return true
+	}
+	if w.Invincible {
+		return false // The hit can't crash the bird, so the shield is kept for later
+	}
+	if w.Power != nil && w.Power.Kind == PowerShield {
+		w.Power = nil
+		w.Shielding = true
+		return true
+	}
+	return false
+}
+
+// Shrunk reports whether the bird has the smaller hitbox of the shrink power-up
+func (w *World) Shrunk() bool {
+	return w.Power != nil && w.Power.Kind == PowerShrink
+}
+
+// scrollSpeed returns the time it takes to scroll one cell, longer in slow-motion
+func (w *World) scrollSpeed() time.Duration {
+	if w.Power != nil && w.Power.Kind == PowerSlowMo {
+		return w.GameSpeed * slowMoFactor
+	}
+	return w.GameSpeed
+}
+
+// birdBox returns the bird's collision box, made smaller by the shrink power-up
+func (w *World) birdBox() Box {
+	if w.Shrunk() {
+		return w.Bird.ShrunkBox(w.Hitbox)
+	}
+	return w.Bird.Box(w.Hitbox)
+}
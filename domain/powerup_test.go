@@ -0,0 +1,72 @@
+package domain
+
+import "testing"
+
+func TestItemsSpawnInEveryMode(t *testing.T) {
+	for _, mode := range []Mode{ModeClassic, ModeTimeAttack, ModeZen} {
+		t.Run(mode.String(), func(t *testing.T) {
+			cfg := testConfig(mode, 5)
+			cfg.PowerUps = true
+			w := NewWorld(cfg)
+			w.Invincible = true
+
+			// Count the pipes spawned with an item in their gap, after the first one
+			// which zen places without drifting
+			pipes, items := 0, 0
+			seen := map[*Pipe]bool{}
+			for i := 0; i < 20000; i++ {
+				w.Step(Input{})
+				for _, p := range w.Pipes {
+					if !seen[p] {
+						seen[p] = true
+						pipes++
+						if pipes > 1 && p.Item != nil {
+							items++
+						}
+					}
+				}
+			}
+
+			if items == 0 {
+				t.Errorf("no items among %d pipes", pipes)
+			}
+		})
+	}
+}
+
+func TestShieldOnlySpentOnCrashes(t *testing.T) {
+	tests := []struct {
+		name          string
+		invincible    bool
+		power         *PowerUp
+		wantCrash     bool
+		wantShield    bool
+		wantShielding bool
+	}{
+		{name: "shield absorbs the hit", power: &PowerUp{Kind: PowerShield}, wantShield: false, wantShielding: true},
+		{name: "invincible bird keeps its shield", invincible: true, power: &PowerUp{Kind: PowerShield}, wantShield: true},
+		{name: "no shield", wantCrash: true},
+		{name: "other power-ups don't shield", power: &PowerUp{Kind: PowerSlowMo, Steps: 10}, wantCrash: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld(testConfig(ModePractice, 1))
+			w.Invincible = tt.invincible
+			w.Power = tt.power
+			// Put the bird inside the bottom half of a pipe
+			w.Pipes = []*Pipe{{X: BirdStartX, Width: PipeWidth, GapY: 2, GapSize: 4}}
+
+			var ev Events
+			if crashed := w.hitObstacle(&ev); crashed != tt.wantCrash {
+				t.Fatalf("crashed = %v, want %v", crashed, tt.wantCrash)
+			}
+			if shield := w.Power != nil && w.Power.Kind == PowerShield; shield != tt.wantShield {
+				t.Errorf("shield left = %v, want %v", shield, tt.wantShield)
+			}
+			if w.Shielding != tt.wantShielding {
+				t.Errorf("Shielding = %v, want %v", w.Shielding, tt.wantShielding)
+			}
+		})
+	}
+}
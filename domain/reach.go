@@ -0,0 +1,87 @@
+package domain
+
+import "math"
+
+const reachPrecision = 8 // Bird positions closer than 1/reachPrecision of a cell count as the same
+
+// reachState is the part of a bird that changes while it flies
+type reachState struct {
+	y        float64
+	velocity float64
+}
+
+// reachKey identifies bird states that play out the same from here on
+type reachKey struct {
+	y        int // Quantised position
+	velocity int // Velocity in steps of gravity
+}
+
+// FirstUnreachable returns the index of the first course pipe that no sequence of jumps gets
+// the bird through, or -1 if the whole course can be cleared
+// Every step it tries both jumping and not jumping from every distinct bird state still alive
+func (c *Course) FirstUnreachable(d Difficulty, hitbox HitboxMode) int {
+	// Pipes scroll the same way for every bird that survives, so one invincible world drives them
+	ref := NewWorld(Config{
+		Mode:       ModeClassic,
+		Difficulty: d,
+		Hitbox:     hitbox,
+		Course:     c,
+		Width:      BirdStartX + 2*PipeWidth, // Pipes only matter once they reach the bird
+		Height:     c.Height,
+	})
+	ref.Invincible = true
+
+	bird := *ref.Bird
+	states := map[reachKey]reachState{}
+	next := map[reachKey]reachState{}
+	states[reachKeyOf(bird.Y, bird.Velocity)] = reachState{y: bird.Y, velocity: bird.Velocity}
+
+	for !ref.Cleared {
+		before := clonePipes(ref.Pipes)
+		ref.Step(Input{})
+
+		clear(next)
+		for _, s := range states {
+			for _, jump := range []bool{false, true} {
+				bird.Y, bird.Velocity = s.y, s.velocity
+				if jump {
+					bird.Jump(DefaultPhysics)
+				}
+				bird.Update(DefaultPhysics)
+
+				// Check against the pipes both before and after they scrolled, like World.Step
+				box := bird.Box(hitbox)
+				if box.Top < 0 || box.Bottom > float64(c.Height) || collides(box, before) || collides(box, ref.Pipes) {
+					continue
+				}
+				next[reachKeyOf(bird.Y, bird.Velocity)] = reachState{y: bird.Y, velocity: bird.Velocity}
+			}
+		}
+
+		if len(next) == 0 {
+			// Some bird passed every pipe before the score, so the next one is the problem
+			return min(ref.Score, len(c.Pipes)-1)
+		}
+		states, next = next, states
+	}
+
+	return -1
+}
+
+// reachKeyOf returns the key of a bird state
+func reachKeyOf(y, velocity float64) reachKey {
+	return reachKey{
+		y:        int(math.Round(y * reachPrecision)),
+		velocity: int(math.Round(velocity / gravity)),
+	}
+}
+
+// collides checks if a box overlaps any of the pipes
+func collides(box Box, pipes []*Pipe) bool {
+	for _, p := range pipes {
+		if p.CollidesWith(box) {
+			return true
+		}
+	}
+	return false
+}
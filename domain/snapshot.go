@@ -0,0 +1,96 @@
+package domain
+
+import "time"
+
+// Snapshot is a copy of the world state that can be restored later
+type Snapshot struct {
+	world World
+}
+
+// Tick returns the step the snapshot was taken at
+func (s Snapshot) Tick() int {
+	return s.world.Tick
+}
+
+// Snapshot copies the current world state
+func (w *World) Snapshot() Snapshot {
+	return Snapshot{world: w.clone()}
+}
+
+// Restore puts the world back into a snapshotted state
+// A snapshot can be restored any number of times
+func (w *World) Restore(s Snapshot) {
+	*w = s.world.clone()
+}
+
+// clone returns a deep copy of the world
+func (w *World) clone() World {
+	c := *w
+	bird := *w.Bird
+	c.Bird = &bird
+	c.Pipes = clonePipes(w.Pipes)
+	if w.Power != nil {
+		power := *w.Power
+		c.Power = &power
+	}
+	c.Splits = append([]time.Duration(nil), w.Splits...)
+	c.Coins = append([]Coin(nil), w.Coins...)
+	c.Zones = append([]Zone(nil), w.Zones...)
+	c.Hazards = make([]Hazard, len(w.Hazards))
+	for i, h := range w.Hazards {
+		c.Hazards[i] = h.Clone()
+	}
+	c.pipeGen = w.pipeGen.Clone()
+	return c
+}
+
+// clonePipes returns deep copies of the pipes
+func clonePipes(pipes []*Pipe) []*Pipe {
+	c := make([]*Pipe, len(pipes))
+	for i, p := range pipes {
+		pipe := *p
+		if p.Behavior != nil {
+			pipe.Behavior = p.Behavior.Clone()
+		}
+		c[i] = &pipe
+	}
+	return c
+}
+
+// History is a ring buffer of the most recent snapshots
+type History struct {
+	snaps []Snapshot
+	start int // Index of the oldest snapshot
+	count int
+}
+
+// NewHistory creates a history holding up to capacity snapshots
+func NewHistory(capacity int) *History {
+	return &History{snaps: make([]Snapshot, capacity)}
+}
+
+// Push adds a snapshot, dropping the oldest one when full
+func (h *History) Push(s Snapshot) {
+	if h.count < len(h.snaps) {
+		h.snaps[(h.start+h.count)%len(h.snaps)] = s
+		h.count++
+		return
+	}
+	h.snaps[h.start] = s
+	h.start = (h.start + 1) % len(h.snaps)
+}
+
+// Len returns the number of snapshots held
+func (h *History) Len() int {
+	return h.count
+}
+
+// Back drops the newest n snapshots and returns the one before them
+// If fewer are held, the oldest snapshot is returned and kept
+func (h *History) Back(n int) (Snapshot, bool) {
+	if h.count == 0 {
+		return Snapshot{}, false
+	}
+	h.count = max(h.count-n, 1)
+	return h.snaps[(h.start+h.count-1)%len(h.snaps)], true
+}
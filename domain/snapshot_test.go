@@ -0,0 +1,94 @@
+package domain
+
+import "testing"
+
+func TestSnapshotRestore(t *testing.T) {
+	w := NewWorld(testConfig(ModeClassic, 3))
+	w.Invincible = true
+	for i := 0; i < 500; i++ {
+		w.Step(autopilot(w))
+	}
+	snap := w.Snapshot()
+	hash := w.Hash()
+
+	// Play on, remembering the inputs and where they led
+	var inputs []Input
+	for i := 0; i < 500; i++ {
+		in := autopilot(w)
+		inputs = append(inputs, in)
+		w.Step(in)
+	}
+	end := w.Hash()
+
+	// A snapshot can be restored more than once and replays the same way every time
+	for attempt := 1; attempt <= 2; attempt++ {
+		w.Restore(snap)
+		if w.Tick != snap.Tick() || w.Hash() != hash {
+			t.Fatalf("attempt %d: restored tick %d, want %d with the snapshotted state", attempt, w.Tick, snap.Tick())
+		}
+		for _, in := range inputs {
+			w.Step(in)
+		}
+		if w.Hash() != end {
+			t.Fatalf("attempt %d: replaying from the snapshot ended in a different state", attempt)
+		}
+	}
+}
+
+func TestSnapshotIsIndependent(t *testing.T) {
+	w := NewWorld(testConfig(ModeClassic, 3))
+	w.Invincible = true
+	for i := 0; i < 500; i++ {
+		w.Step(Input{})
+	}
+	snap := w.Snapshot()
+	hash := w.Hash()
+
+	// Changing the world in place must not reach into the snapshot
+	w.Bird.Y = 0
+	w.Pipes[0].GapY++
+	w.Score += 10
+
+	w.Restore(snap)
+	if w.Hash() != hash {
+		t.Error("changes to the world leaked into its snapshot")
+	}
+}
+
+func TestHistoryBack(t *testing.T) {
+	tests := []struct {
+		name     string
+		pushed   int
+		back     int
+		wantTick int
+		wantLen  int
+	}{
+		{name: "one back", pushed: 5, back: 1, wantTick: 4, wantLen: 4},
+		{name: "wraps around", pushed: 12, back: 3, wantTick: 9, wantLen: 5},
+		{name: "keeps the oldest", pushed: 12, back: 20, wantTick: 5, wantLen: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHistory(8)
+			w := NewWorld(testConfig(ModeClassic, 1))
+			for i := 1; i <= tt.pushed; i++ {
+				w.Tick = i
+				h.Push(w.Snapshot())
+			}
+
+			snap, ok := h.Back(tt.back)
+			if !ok {
+				t.Fatal("Back found no snapshot")
+			}
+			if snap.Tick() != tt.wantTick || h.Len() != tt.wantLen {
+				t.Errorf("Back(%d) = tick %d with %d left, want tick %d with %d left",
+					tt.back, snap.Tick(), h.Len(), tt.wantTick, tt.wantLen)
+			}
+		})
+	}
+
+	if _, ok := NewHistory(4).Back(1); ok {
+		t.Error("Back on an empty history found a snapshot")
+	}
+}
@@ -0,0 +1,31 @@
+package domain
+
+// Sprites are the bird appearances, the first one free and the rest unlocked with coins
+// They all share DefaultSprite's hitbox, so an appearance never changes how a run plays
+var Sprites = []Sprite{
+	DefaultSprite,
+	{Name: "Crow", Up: "^●", Down: "v●", Price: 25, Hitbox: DefaultSprite.Hitbox},
+	{Name: "Owl", Up: "^◎", Down: "v◎", Price: 50, Hitbox: DefaultSprite.Hitbox},
+	{Name: "Dart", Up: "^>", Down: "v>", Price: 75, Hitbox: DefaultSprite.Hitbox},
+	{Name: "Heart", Up: "^♥", Down: "v♥", Price: 100, Hitbox: DefaultSprite.Hitbox},
+}
+
+// SpriteByName returns the sprite with the given name, or DefaultSprite if there is none
+func SpriteByName(name string) Sprite {
+	for _, s := range Sprites {
+		if s.Name == name {
+			return s
+		}
+	}
+	return DefaultSprite
+}
+
+// Glyphs returns the sprite's glyphs for a bird moving at the given velocity
+func (s Sprite) Glyphs(velocity float64) string {
+	if velocity < 0 {
+		// Going up
+		return s.Up
+	}
+	// Going down
+	return s.Down
+}
@@ -9,14 +9,20 @@ const (
 	ThemeClassic Theme = iota
 	ThemeRetro
 	ThemeNeon
+	ThemeSunset // Unlocked in the shop
+	ThemeOcean  // Unlocked in the shop
+	ThemeMono   // Unlocked in the shop
 )
 
+const themeCount = 6
+
 // ColorScheme holds the colors for a theme
 type ColorScheme struct {
 	Title     lipgloss.Color
 	Score     lipgloss.Color
 	GameOver  lipgloss.Color
 	NewRecord lipgloss.Color
+	Ghost     lipgloss.Color
 }
 
 // GetColors returns the color scheme for a theme
@@ -28,6 +34,7 @@ func (t Theme) GetColors() ColorScheme {
 			Score:     lipgloss.Color("10"), // Green
 			GameOver:  lipgloss.Color("9"),  // Red
 			NewRecord: lipgloss.Color("11"), // Yellow
+			Ghost:     lipgloss.Color("2"),  // Dark green
 		}
 	case ThemeNeon:
 		return ColorScheme{
@@ -35,6 +42,31 @@ func (t Theme) GetColors() ColorScheme {
 			Score:     lipgloss.Color("14"), // Cyan
 			GameOver:  lipgloss.Color("9"),  // Red
 			NewRecord: lipgloss.Color("11"), // Yellow
+			Ghost:     lipgloss.Color("5"),  // Dark magenta
+		}
+	case ThemeSunset:
+		return ColorScheme{
+			Title:     lipgloss.Color("208"), // Orange
+			Score:     lipgloss.Color("214"), // Light orange
+			GameOver:  lipgloss.Color("160"), // Deep red
+			NewRecord: lipgloss.Color("226"), // Bright yellow
+			Ghost:     lipgloss.Color("95"),  // Dusky brown
+		}
+	case ThemeOcean:
+		return ColorScheme{
+			Title:     lipgloss.Color("33"),  // Deep blue
+			Score:     lipgloss.Color("45"),  // Turquoise
+			GameOver:  lipgloss.Color("203"), // Coral
+			NewRecord: lipgloss.Color("123"), // Foam
+			Ghost:     lipgloss.Color("24"),  // Dark blue
+		}
+	case ThemeMono:
+		return ColorScheme{
+			Title:     lipgloss.Color("15"), // White
+			Score:     lipgloss.Color("7"),  // Light gray
+			GameOver:  lipgloss.Color("15"), // White
+			NewRecord: lipgloss.Color("15"), // White
+			Ghost:     lipgloss.Color("8"),  // Gray
 		}
 	default: // ThemeClassic
 		return ColorScheme{
@@ -42,6 +74,7 @@ func (t Theme) GetColors() ColorScheme {
 			Score:     lipgloss.Color("10"), // Green
 			GameOver:  lipgloss.Color("9"),  // Red
 			NewRecord: lipgloss.Color("11"), // Yellow
+			Ghost:     lipgloss.Color("8"),  // Gray
 		}
 	}
 }
@@ -53,12 +86,48 @@ func (t Theme) String() string {
 		return "Retro"
 	case ThemeNeon:
 		return "Neon"
+	case ThemeSunset:
+		return "Sunset"
+	case ThemeOcean:
+		return "Ocean"
+	case ThemeMono:
+		return "Mono"
 	default:
 		return "Classic"
 	}
 }
 
+// ParseTheme returns the theme for a name produced by String
+func ParseTheme(s string) Theme {
+	switch s {
+	case "Retro":
+		return ThemeRetro
+	case "Neon":
+		return ThemeNeon
+	case "Sunset":
+		return ThemeSunset
+	case "Ocean":
+		return ThemeOcean
+	case "Mono":
+		return ThemeMono
+	default:
+		return ThemeClassic
+	}
+}
+
+// Price returns the coins needed to unlock the theme in the shop, 0 if it is always available
+func (t Theme) Price() int {
+	switch t {
+	case ThemeSunset, ThemeOcean:
+		return 30
+	case ThemeMono:
+		return 60
+	default:
+		return 0
+	}
+}
+
 // Next returns the next theme in rotation
 func (t Theme) Next() Theme {
-	return (t + 1) % 3
+	return (t + 1) % themeCount
 }
@@ -0,0 +1,410 @@
+package domain
+
+import "time"
+
+// StepDuration is the fixed physics timestep
+// Difficulty only changes how fast pipes scroll, so the bird feels the same at every speed
+const StepDuration = 15 * time.Millisecond
+
+const (
+	pipeSpawnGap = 50 // Horizontal gap between pipes
+	BirdStartX   = 10 // Bird's horizontal position
+)
+
+// Input holds the player's actions for a single step
+type Input struct {
+	Jump bool
+}
+
+// Events reports what happened during a single step
+type Events struct {
+	Jumped    bool // The bird flapped
+	Scored    bool // The bird passed a pipe
+	Died      bool // The bird hit a pipe, the ceiling or the floor
+	Respawned bool // The bird crashed and was put back at the last checkpoint
+	Finished  bool // The run reached its time limit, pipe target or finish line
+	PoweredUp bool // The bird collected a power-up
+}
+
+// Config holds the settings that define a run
+// Two worlds with the same config and inputs play out identically
+type Config struct {
+	Seed       uint64
+	Mode       Mode
+	Difficulty Difficulty
+	Hitbox     HitboxMode
+	Target     int     // Pipes to pass in a speedrun, 0 otherwise
+	Course     *Course // Handcrafted pipes replacing the generator, nil for random pipes
+	PowerUps   bool    // Whether power-up items spawn in pipe gaps
+	Width      int     // Playfield width
+	Height     int     // Playfield height
+}
+
+// World holds the simulated game state
+// It has no timers and does no IO, so it can be stepped as fast as needed
+type World struct {
+	Bird            *Bird
+	Pipes           []*Pipe
+	Hazards         []Hazard // Moving obstacles between the pipes
+	Zones           []Zone   // Stretches with their own physics
+	Score           int
+	Tick            int           // Number of steps simulated
+	Width           int           // Playfield width, fixed for the whole run
+	Height          int           // Playfield height, fixed for the whole run
+	GameSpeed       time.Duration // Time to scroll one cell, shortened as the score increases
+	Mode            Mode
+	Difficulty      Difficulty
+	Hitbox          HitboxMode
+	Seed            uint64
+	Target          int             // Pipes to pass in a speedrun, 0 otherwise
+	Splits          []time.Duration // Time at every SplitInterval pipes and at the target
+	Over            bool            // Set once the run has ended
+	Penalty         int             // Steps taken off the time limit by collisions
+	Invincible      bool            // Collisions are ignored, practice mode only
+	Crashes         int             // Number of collisions that respawned the bird
+	Course          *Course         // Handcrafted pipes replacing the generator, nil for random pipes
+	Distance        int             // Cells scrolled since the start
+	Cleared         bool            // Set once the bird crossed the course's finish line
+	PowerUps        bool            // Whether power-up items spawn in pipe gaps
+	Power           *PowerUp        // Active power-up, nil if there is none
+	Shielding       bool            // A shield absorbed a hit and the bird is still inside the pipe
+	Coins           []Coin          // Coins on screen waiting to be collected
+	CoinsCollected  int             // Coins picked up during the run
+	scroll          float64         // Scroll progress towards the next whole cell
+	nextPipe        int             // Index of the next course pipe to spawn
+	nextPipeAt      int             // Distance of the next course pipe from the bird's start
+	gapsSinceHazard int             // Gaps between pipes since the last hazard appeared
+	gapsSinceZone   int             // Gaps between pipes since the last zone appeared
+	pipeGen         *PipeGenerator
+	checkpoint      *World // State right after the last passed pipe, for modes that respawn
+}
+
+// NewWorld creates a world for a run
+func NewWorld(cfg Config) *World {
+	w := &World{
+		Bird:       NewBird(BirdStartX, cfg.Height/2),
+		Pipes:      []*Pipe{}, // Start with no pipes - gives player time to adjust
+		Width:      cfg.Width,
+		Height:     cfg.Height,
+		GameSpeed:  cfg.Difficulty.GetSettings().InitialSpeed,
+		Mode:       cfg.Mode,
+		Difficulty: cfg.Difficulty,
+		Hitbox:     cfg.Hitbox,
+		Seed:       cfg.Seed,
+		Target:     cfg.Target,
+		Course:     cfg.Course,
+		PowerUps:   cfg.PowerUps,
+		pipeGen:    NewPipeGenerator(cfg.Seed),
+	}
+	if w.Course != nil {
+		// Course pipes that are already in view are there from the start
+		w.nextPipeAt = w.Course.Pipes[0].Spacing
+		w.spawnCoursePipes()
+	}
+	if w.Mode.Respawns() {
+		w.saveCheckpoint()
+	}
+	return w
+}
+
+// Step advances the world by one StepDuration
+func (w *World) Step(in Input) Events {
+	var ev Events
+	if w.Over {
+		return ev
+	}
+	w.Tick++
+	w.wearOff()
+
+	// End timed runs once the limit, minus penalties, is used up
+	if limit := w.Mode.TimeLimit(); limit > 0 && w.Elapsed()+w.penaltyTime() >= limit {
+		w.Over = true
+		ev.Finished = true
+		return ev
+	}
+
+	// Zones change the forces on the bird
+	physics := w.physics()
+	if in.Jump {
+		w.Bird.Jump(physics)
+		ev.Jumped = true
+	}
+
+	// Update bird physics
+	w.Bird.Update(physics)
+	w.blow(physics)
+
+	// Check ceiling/floor collision
+	box := w.birdBox()
+	if box.Top < 0 || box.Bottom > float64(w.Height) {
+		if w.Invincible {
+			w.keepInBounds(box)
+		} else {
+			w.crash(&ev)
+			return ev
+		}
+	}
+
+	// Move hazards on their own, then check collision after everything moved
+	w.stepHazards()
+	if w.hitObstacle(&ev) {
+		return ev
+	}
+	w.collectItems(&ev)
+	w.collectCoins()
+
+	// Scroll pipes one whole cell at a time so none can be skipped at high speed
+	w.scroll += float64(StepDuration) / float64(w.scrollSpeed())
+	for w.scroll >= 1 {
+		w.scroll--
+		scored := w.scrollPipes()
+		if scored {
+			ev.Scored = true
+		}
+
+		// Check collision after the pipes moved
+		if w.hitObstacle(&ev) {
+			return ev
+		}
+		w.collectItems(&ev)
+		w.collectCoins()
+
+		// Record speedrun splits and stop once the target is passed
+		if scored && w.reachedTarget() {
+			ev.Finished = true
+			return ev
+		}
+
+		// Courses end once the bird crosses the finish line
+		if w.Course != nil && w.FinishX() <= w.Bird.X {
+			w.Over = true
+			w.Cleared = true
+			ev.Finished = true
+			return ev
+		}
+
+		// Respawn right after the last pipe that was passed cleanly
+		if scored && w.Mode.Respawns() {
+			w.saveCheckpoint()
+		}
+	}
+
+	return ev
+}
+
+// reachedTarget records a split when one is due and ends the run once the target is passed
+func (w *World) reachedTarget() bool {
+	if w.Target == 0 {
+		return false
+	}
+	if w.Score%SplitInterval == 0 || w.Score >= w.Target {
+		w.Splits = append(w.Splits, w.Elapsed())
+	}
+	if w.Score >= w.Target {
+		w.Over = true
+		return true
+	}
+	return false
+}
+
+// crash handles a collision and reports whether the step has to stop
+func (w *World) crash(ev *Events) bool {
+	if w.Invincible {
+		return false
+	}
+
+	if w.Mode.Respawns() {
+		w.respawn()
+		ev.Respawned = true
+		return true
+	}
+
+	w.Over = true
+	ev.Died = true
+	return true
+}
+
+// saveCheckpoint remembers the current state to respawn from
+func (w *World) saveCheckpoint() {
+	cp := w.clone()
+	cp.checkpoint = nil
+	w.checkpoint = &cp
+}
+
+// respawn puts the bird back at the last checkpoint
+// The clock keeps running, so time spent before the crash is not refunded
+func (w *World) respawn() {
+	cp := w.checkpoint
+	tick, crashes, penalty, invincible := w.Tick, w.Crashes, w.Penalty, w.Invincible
+	*w = cp.clone()
+	w.checkpoint = cp
+	w.Tick = tick
+	w.Crashes = crashes + 1
+	w.Penalty = penalty + int(w.Mode.CrashPenalty()/StepDuration)
+	w.Invincible = invincible
+}
+
+// keepInBounds stops an invincible bird at the ceiling or floor
+func (w *World) keepInBounds(box Box) {
+	if box.Top < 0 {
+		w.Bird.Y -= box.Top
+	} else {
+		w.Bird.Y -= box.Bottom - float64(w.Height)
+	}
+	w.Bird.Velocity = 0
+}
+
+// Elapsed returns the simulated time of the run
+func (w *World) Elapsed() time.Duration {
+	return time.Duration(w.Tick) * StepDuration
+}
+
+// TimeLeft returns the time remaining in a timed run, including penalties
+func (w *World) TimeLeft() time.Duration {
+	return max(w.Mode.TimeLimit()-w.Elapsed()-w.penaltyTime(), 0)
+}
+
+// penaltyTime returns the time lost to collisions
+func (w *World) penaltyTime() time.Duration {
+	return time.Duration(w.Penalty) * StepDuration
+}
+
+// hitObstacle handles a collision the shield doesn't absorb and reports whether the step has to stop
+func (w *World) hitObstacle(ev *Events) bool {
+	if !w.hitsObstacle() {
+		w.Shielding = false
+		return false
+	}
+	return !w.shielded() && w.crash(ev)
+}
+
+// hitsObstacle checks if the bird collides with any pipe or hazard
+func (w *World) hitsObstacle() bool {
+	box := w.birdBox()
+	for _, pipe := range w.Pipes {
+		if pipe.CollidesWith(box) {
+			return true
+		}
+	}
+	for _, h := range w.Hazards {
+		if h.CollidesWith(box) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrollPipes moves every pipe one cell left and reports whether a pipe was passed
+func (w *World) scrollPipes() bool {
+	scored := false
+	settings := w.Difficulty.GetSettings()
+	w.Distance++
+	w.scrollCoins()
+	w.scrollHazards()
+	w.scrollZones()
+	for i := len(w.Pipes) - 1; i >= 0; i-- {
+		pipe := w.Pipes[i]
+		pipe.Update()
+
+		// Check if passed
+		if pipe.IsPassed(w.Bird) {
+			pipe.Passed = true
+			w.Score++
+			scored = true
+
+			// Increase difficulty based on settings
+			if w.Mode.Accelerates() && w.Score%settings.ScoreInterval == 0 {
+				// Speed up scrolling (reduce interval)
+				if w.GameSpeed > settings.MinSpeed {
+					w.GameSpeed -= settings.SpeedIncrement
+				}
+			}
+		}
+
+		// Remove off-screen pipes
+		if pipe.IsOffScreen() {
+			w.Pipes = append(w.Pipes[:i], w.Pipes[i+1:]...)
+		}
+	}
+
+	// Spawn new pipes
+	if w.Course != nil {
+		w.spawnCoursePipes()
+	} else if len(w.Pipes) == 0 || w.Pipes[len(w.Pipes)-1].X < w.Width-pipeSpawnGap {
+		w.addPipe(w.newPipe(settings))
+		w.spawnZones()
+	}
+	w.spawnHazards(settings)
+
+	return scored
+}
+
+// spawnCoursePipes adds the course pipes that have scrolled into view
+func (w *World) spawnCoursePipes() {
+	pipes := w.Course.Pipes
+	for w.nextPipe < len(pipes) && BirdStartX+w.nextPipeAt-w.Distance <= w.Width {
+		p := pipes[w.nextPipe]
+		w.addPipe(&Pipe{
+			X:       BirdStartX + w.nextPipeAt - w.Distance,
+			Width:   p.Width,
+			GapY:    p.GapY,
+			GapSize: p.GapSize,
+		})
+		w.nextPipe++
+		if w.nextPipe < len(pipes) {
+			w.nextPipeAt += pipes[w.nextPipe].Spacing
+		}
+	}
+}
+
+// FinishX returns the on-screen position of the course's finish line
+func (w *World) FinishX() int {
+	return BirdStartX + w.Course.Length() - w.Distance
+}
+
+// addPipe adds a pipe behind the others, with coins on the way to its gap
+func (w *World) addPipe(p *Pipe) {
+	if len(w.Pipes) > 0 {
+		w.placeCoins(w.Pipes[len(w.Pipes)-1], p)
+	}
+	w.Pipes = append(w.Pipes, p)
+}
+
+// newPipe creates the next pipe at the right edge, close to the last one when the mode drifts gaps
+// Drifting gaps stay static; other pipes may get one of the difficulty's behaviours
+func (w *World) newPipe(settings DifficultySettings) *Pipe {
+	var pipe *Pipe
+	if w.Mode.DriftingGaps() && len(w.Pipes) > 0 {
+		pipe = w.pipeGen.DriftPipe(w.Pipes[len(w.Pipes)-1], w.Width, w.Height, settings.PipeGap)
+	} else {
+		pipe = w.pipeGen.NewPipe(w.Width, w.Height, settings.PipeGap)
+		if !w.Mode.DriftingGaps() {
+			w.pipeGen.AddBehavior(pipe, settings, w.Height)
+		}
+	}
+	if w.PowerUps {
+		w.pipeGen.AddItem(pipe)
+	}
+	return pipe
+}
+
+// InputTape feeds recorded jumps back into a world one step at a time
+type InputTape struct {
+	Jumps []int // Tick index of every jump, in ascending order
+	next  int
+}
+
+// NewInputTape creates a tape for the given jump ticks
+func NewInputTape(jumps []int) *InputTape {
+	return &InputTape{Jumps: jumps}
+}
+
+// Next returns the input for the step that starts at the given tick
+func (t *InputTape) Next(tick int) Input {
+	var in Input
+	for t.next < len(t.Jumps) && t.Jumps[t.next] <= tick {
+		in.Jump = true
+		t.next++
+	}
+	return in
+}
@@ -0,0 +1,239 @@
+package domain
+
+import "testing"
+
+// testConfig returns the config of a plain run on an 80x24 playfield
+func testConfig(mode Mode, seed uint64) Config {
+	return Config{
+		Seed:       seed,
+		Mode:       mode,
+		Difficulty: DifficultyEasy,
+		Hitbox:     HitboxPrecise,
+		Width:      80,
+		Height:     24,
+	}
+}
+
+// autopilot flaps whenever the bird sinks well below the middle of the next gap
+func autopilot(w *World) Input {
+	target := float64(w.Height) / 2
+	for _, p := range w.Pipes {
+		if p.X+p.Width >= w.Bird.X {
+			target = float64(p.GapY) + float64(p.GapSize)/2
+			break
+		}
+	}
+	return Input{Jump: w.Bird.Y > target+3 && w.Bird.Velocity > 0}
+}
+
+func TestStepBirdPhysics(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         Input
+		wantJumped bool
+		wantRising bool
+	}{
+		{name: "falls without input", in: Input{}, wantJumped: false, wantRising: false},
+		{name: "rises after a jump", in: Input{Jump: true}, wantJumped: true, wantRising: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld(testConfig(ModeClassic, 1))
+			startY := w.Bird.Y
+
+			ev := w.Step(tt.in)
+			if ev.Jumped != tt.wantJumped {
+				t.Errorf("Jumped = %v, want %v", ev.Jumped, tt.wantJumped)
+			}
+			if rising := w.Bird.Y < startY; rising != tt.wantRising {
+				t.Errorf("bird moved from %.3f to %.3f, want rising = %v", startY, w.Bird.Y, tt.wantRising)
+			}
+			if w.Tick != 1 {
+				t.Errorf("Tick = %d, want 1", w.Tick)
+			}
+		})
+	}
+}
+
+func TestStepCrash(t *testing.T) {
+	tests := []struct {
+		mode          Mode
+		wantRespawned bool
+	}{
+		{mode: ModeClassic, wantRespawned: false},
+		{mode: ModeZen, wantRespawned: false},
+		{mode: ModeVersus, wantRespawned: false},
+		{mode: ModePractice, wantRespawned: true},
+		{mode: ModeTimeAttack, wantRespawned: true},
+		{mode: ModeSpeedrun, wantRespawned: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			w := NewWorld(testConfig(tt.mode, 1))
+
+			// Without flapping the bird hits the floor
+			var ev Events
+			for i := 0; i < 1000 && !ev.Died && !ev.Respawned; i++ {
+				ev = w.Step(Input{})
+			}
+
+			if ev.Respawned != tt.wantRespawned || ev.Died == tt.wantRespawned {
+				t.Fatalf("events = %+v, want Respawned = %v", ev, tt.wantRespawned)
+			}
+			if w.Over == tt.wantRespawned {
+				t.Errorf("Over = %v, want %v", w.Over, !tt.wantRespawned)
+			}
+			if tt.wantRespawned && (w.Crashes != 1 || w.Bird.Y != float64(w.Height/2)) {
+				t.Errorf("after respawn Crashes = %d, bird at %.3f, want 1 crash at the start", w.Crashes, w.Bird.Y)
+			}
+		})
+	}
+}
+
+func TestStepAfterOver(t *testing.T) {
+	w := NewWorld(testConfig(ModeClassic, 1))
+	for !w.Over {
+		w.Step(Input{})
+	}
+	tick := w.Tick
+
+	if ev := w.Step(Input{Jump: true}); ev != (Events{}) {
+		t.Errorf("events after game over = %+v, want none", ev)
+	}
+	if w.Tick != tick {
+		t.Errorf("Tick moved from %d to %d after game over", tick, w.Tick)
+	}
+}
+
+func TestStepInvincibleStaysInBounds(t *testing.T) {
+	w := NewWorld(testConfig(ModePractice, 1))
+	w.Invincible = true
+
+	for i := 0; i < 2000; i++ {
+		ev := w.Step(Input{Jump: i%2 == 0})
+		if ev.Died || ev.Respawned {
+			t.Fatalf("invincible bird crashed at tick %d", w.Tick)
+		}
+		// Allow for rounding when the box is pushed back against the edge
+		if box := w.birdBox(); box.Top < -1e-9 || box.Bottom > float64(w.Height)+1e-9 {
+			t.Fatalf("bird box %+v left the playfield at tick %d", box, w.Tick)
+		}
+	}
+}
+
+func TestStepScoring(t *testing.T) {
+	w := NewWorld(testConfig(ModeClassic, 1))
+	w.Invincible = true // Score without having to fly well
+
+	scored := 0
+	for i := 0; i < 5000 && w.Score < 3; i++ {
+		if w.Step(autopilot(w)).Scored {
+			scored++
+		}
+	}
+
+	if w.Score != 3 {
+		t.Fatalf("Score = %d after 5000 steps, want 3", w.Score)
+	}
+	if scored != w.Score {
+		t.Errorf("Scored reported %d times for a score of %d", scored, w.Score)
+	}
+	for _, p := range w.Pipes {
+		if p.Passed != (p.X+p.Width < w.Bird.X+1) {
+			t.Errorf("pipe at %d has Passed = %v", p.X, p.Passed)
+		}
+	}
+}
+
+func TestSeedDeterminism(t *testing.T) {
+	configs := []struct {
+		name string
+		cfg  Config
+	}{
+		{name: "classic", cfg: testConfig(ModeClassic, 42)},
+		{name: "zen", cfg: testConfig(ModeZen, 42)},
+		{name: "hard with power-ups", cfg: Config{Seed: 7, Mode: ModeTimeAttack, Difficulty: DifficultyHard, Hitbox: HitboxForgiving, PowerUps: true, Width: 100, Height: 30}},
+	}
+
+	for _, tt := range configs {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewWorld(tt.cfg), NewWorld(tt.cfg)
+
+			// The same inputs on the same seed play out the same, step for step
+			for i := 0; i < 3000 && !a.Over; i++ {
+				in := autopilot(a)
+				evA, evB := a.Step(in), b.Step(in)
+				if evA != evB {
+					t.Fatalf("tick %d: events %+v and %+v differ", a.Tick, evA, evB)
+				}
+				if a.Hash() != b.Hash() {
+					t.Fatalf("tick %d: worlds diverged", a.Tick)
+				}
+			}
+		})
+	}
+}
+
+func TestSeedChangesPipes(t *testing.T) {
+	gaps := func(seed uint64) []int {
+		w := NewWorld(testConfig(ModeClassic, seed))
+		w.Invincible = true
+		var gaps []int
+		for i := 0; i < 3000; i++ {
+			w.Step(Input{})
+		}
+		for _, p := range w.Pipes {
+			gaps = append(gaps, p.GapY)
+		}
+		return gaps
+	}
+
+	a, b := gaps(1), gaps(2)
+	if len(a) == 0 {
+		t.Fatal("no pipes spawned")
+	}
+	same := len(a) == len(b)
+	for i := 0; same && i < len(a); i++ {
+		same = a[i] == b[i]
+	}
+	if same {
+		t.Errorf("seeds 1 and 2 both placed gaps at %v", a)
+	}
+}
+
+func TestInputTape(t *testing.T) {
+	tape := NewInputTape([]int{0, 3, 3, 7})
+	want := map[int]bool{0: true, 3: true, 7: true}
+
+	for tick := 0; tick < 10; tick++ {
+		if got := tape.Next(tick).Jump; got != want[tick] {
+			t.Errorf("tick %d: Jump = %v, want %v", tick, got, want[tick])
+		}
+	}
+}
+
+func TestPhysicsSameOnEveryDifficulty(t *testing.T) {
+	// Difficulty only changes how fast pipes scroll, never how the bird moves per step
+	trajectory := func(d Difficulty) []float64 {
+		cfg := testConfig(ModeClassic, 1)
+		cfg.Difficulty = d
+		w := NewWorld(cfg)
+		var ys []float64
+		for i := 0; i < 20; i++ {
+			w.Step(Input{Jump: i%8 == 0})
+			ys = append(ys, w.Bird.Y)
+		}
+		return ys
+	}
+
+	easy := trajectory(DifficultyEasy)
+	for _, d := range []Difficulty{DifficultyNormal, DifficultyHard} {
+		for i, y := range trajectory(d) {
+			if y != easy[i] {
+				t.Fatalf("%s: bird at %.3f on step %d, %.3f on Easy", d, y, i+1, easy[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package domain
+
+import "math/rand/v2"
+
+// Zone settings
+const (
+	zoneStartScore = 10               // Zones only appear once a run has passed this many pipes
+	zoneEvery      = 4                // Gaps between pipes per zone
+	zoneWidth      = 2 * pipeSpawnGap // Width of a zone, about two pipes
+	windForce      = 0.02             // Cells per step the wind pushes the bird
+	windRecovery   = 0.01             // Cells per step the bird flies back to its column in calm air
+	minBirdX       = 2                // Leftmost column the wind can push the bird to
+	maxBirdX       = BirdStartX + 8   // Rightmost column the wind can push the bird to
+)
+
+// Physics holds the forces acting on the bird, which zones can change
+type Physics struct {
+	Gravity   float64 // Added to the velocity every step, negative pulls upwards
+	JumpForce float64 // Velocity set by a jump, away from where gravity pulls
+	Wind      float64 // Horizontal push in cells per step, negative towards the left
+}
+
+// DefaultPhysics applies everywhere outside zones
+var DefaultPhysics = Physics{Gravity: gravity, JumpForce: jumpForce}
+
+// ZoneKind identifies how a zone changes physics
+type ZoneKind int
+
+const (
+	ZoneHeadwind       ZoneKind = iota // Wind pushes the bird back
+	ZoneTailwind                       // Wind pushes the bird towards the pipes
+	ZoneLowGravity                     // The bird falls slower and jumps higher
+	ZoneHeavyGravity                   // The bird falls faster and jumps lower
+	ZoneReverseGravity                 // The bird falls upwards and jumps down
+)
+
+const zoneKinds = 5
+
+// String returns the string representation of the zone kind
+func (k ZoneKind) String() string {
+	switch k {
+	case ZoneTailwind:
+		return "Tailwind"
+	case ZoneLowGravity:
+		return "Low gravity"
+	case ZoneHeavyGravity:
+		return "Heavy gravity"
+	case ZoneReverseGravity:
+		return "Reverse gravity"
+	default:
+		return "Headwind"
+	}
+}
+
+// Zone is a stretch of the playfield with its own physics, scrolling with the pipes
+type Zone struct {
+	X     int // Left edge
+	Width int
+	Kind  ZoneKind
+}
+
+// Contains checks if a column lies inside the zone
+func (z *Zone) Contains(x int) bool {
+	return x >= z.X && x < z.X+z.Width
+}
+
+// Physics returns the forces inside the zone
+func (z *Zone) Physics() Physics {
+	p := DefaultPhysics
+	switch z.Kind {
+	case ZoneHeadwind:
+		p.Wind = -windForce
+	case ZoneTailwind:
+		p.Wind = windForce
+	case ZoneLowGravity:
+		p.Gravity *= 0.5
+	case ZoneHeavyGravity:
+		p.Gravity *= 1.5
+	case ZoneReverseGravity:
+		p.Gravity = -p.Gravity
+		p.JumpForce = -p.JumpForce
+	}
+	return p
+}
+
+// NewZone creates a zone of a random kind at the right edge of the screen
+func (g *PipeGenerator) NewZone(screenWidth int) Zone {
+	return Zone{X: screenWidth, Width: zoneWidth, Kind: ZoneKind(rand.New(&g.zones).IntN(zoneKinds))}
+}
+
+// ZoneAt returns the zone the bird is in, nil if it is in calm air
+func (w *World) ZoneAt() *Zone {
+	for i := range w.Zones {
+		if w.Zones[i].Contains(w.Bird.X + 1) {
+			return &w.Zones[i]
+		}
+	}
+	return nil
+}
+
+// physics returns the forces acting on the bird where it is
+func (w *World) physics() Physics {
+	if z := w.ZoneAt(); z != nil {
+		return z.Physics()
+	}
+	return DefaultPhysics
+}
+
+// blow pushes the bird with the wind, or lets it fly back to its column in calm air
+func (w *World) blow(p Physics) {
+	b := w.Bird
+	switch {
+	case p.Wind != 0:
+		b.Drift += p.Wind
+	case b.X < BirdStartX:
+		b.Drift += windRecovery
+	case b.X > BirdStartX:
+		b.Drift -= windRecovery
+	default:
+		b.Drift = 0
+	}
+
+	// Move whole cells, like the pipes
+	for b.Drift >= 1 {
+		b.X++
+		b.Drift--
+	}
+	for b.Drift <= -1 {
+		b.X--
+		b.Drift++
+	}
+	b.X = min(max(b.X, minBirdX), maxBirdX)
+}
+
+// scrollZones moves every zone one cell left and drops the ones that left the screen
+func (w *World) scrollZones() {
+	zones := w.Zones[:0]
+	for _, z := range w.Zones {
+		z.X--
+		if z.X+z.Width >= 0 {
+			zones = append(zones, z)
+		}
+	}
+	w.Zones = zones
+}
+
+// spawnZones adds a zone at the right edge every few pipes once a run is under way
+func (w *World) spawnZones() {
+	if w.Course != nil || !w.Mode.HasHazards() || w.Score < zoneStartScore {
+		return
+	}
+	w.gapsSinceZone++
+	if w.gapsSinceZone < zoneEvery {
+		return
+	}
+	w.gapsSinceZone = 0
+	w.Zones = append(w.Zones, w.pipeGen.NewZone(w.Width))
+}
@@ -0,0 +1,105 @@
+package domain
+
+import "testing"
+
+func TestWindDrift(t *testing.T) {
+	tests := []struct {
+		name  string
+		x     int
+		drift float64
+		wind  float64
+		wantX int
+	}{
+		{name: "headwind pushes back", x: BirdStartX, drift: -0.99, wind: -windForce, wantX: BirdStartX - 1},
+		{name: "tailwind pushes forward", x: BirdStartX, drift: 0.99, wind: windForce, wantX: BirdStartX + 1},
+		{name: "headwind builds up", x: BirdStartX, wind: -windForce, wantX: BirdStartX},
+		{name: "headwind stops at the left edge", x: minBirdX, drift: -0.99, wind: -windForce, wantX: minBirdX},
+		{name: "tailwind stops short of the pipes", x: maxBirdX, drift: 0.99, wind: windForce, wantX: maxBirdX},
+		{name: "calm air brings the bird forward", x: BirdStartX - 1, drift: 0.995, wantX: BirdStartX},
+		{name: "calm air brings the bird back", x: BirdStartX + 1, drift: -0.995, wantX: BirdStartX},
+		{name: "calm air at the bird's column", x: BirdStartX, drift: 0.5, wantX: BirdStartX},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld(testConfig(ModePractice, 1))
+			w.Bird.X, w.Bird.Drift = tt.x, tt.drift
+
+			p := DefaultPhysics
+			p.Wind = tt.wind
+			w.blow(p)
+
+			if w.Bird.X != tt.wantX {
+				t.Errorf("bird at column %d, want %d", w.Bird.X, tt.wantX)
+			}
+			if w.Bird.Drift <= -1 || w.Bird.Drift >= 1 {
+				t.Errorf("drift %v left over after moving", w.Bird.Drift)
+			}
+			if tt.wind == 0 && tt.x == BirdStartX && w.Bird.Drift != 0 {
+				t.Errorf("drift %v kept at the bird's column", w.Bird.Drift)
+			}
+		})
+	}
+}
+
+func TestZonePhysics(t *testing.T) {
+	tests := []struct {
+		kind     ZoneKind
+		wantFall float64 // Velocity after the first step from rest, positive downwards
+		wantJump float64 // Velocity of a jump, positive downwards
+		wantWind float64
+	}{
+		{kind: ZoneHeadwind, wantFall: gravity, wantJump: jumpForce, wantWind: -windForce},
+		{kind: ZoneTailwind, wantFall: gravity, wantJump: jumpForce, wantWind: windForce},
+		{kind: ZoneLowGravity, wantFall: gravity * 0.5, wantJump: jumpForce},
+		{kind: ZoneHeavyGravity, wantFall: gravity * 1.5, wantJump: jumpForce},
+		{kind: ZoneReverseGravity, wantFall: -gravity, wantJump: -jumpForce},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			z := Zone{Width: zoneWidth, Kind: tt.kind}
+			p := z.Physics()
+
+			bird := NewBird(BirdStartX, 10)
+			bird.Update(p)
+			if bird.Velocity != tt.wantFall {
+				t.Errorf("velocity %v after falling from rest, want %v", bird.Velocity, tt.wantFall)
+			}
+			bird.Jump(p)
+			if bird.Velocity != tt.wantJump {
+				t.Errorf("jump velocity %v, want %v", bird.Velocity, tt.wantJump)
+			}
+			if p.Wind != tt.wantWind {
+				t.Errorf("wind %v, want %v", p.Wind, tt.wantWind)
+			}
+		})
+	}
+}
+
+func TestReverseGravityZone(t *testing.T) {
+	w := NewWorld(testConfig(ModePractice, 1))
+	w.Invincible = true
+	w.Zones = []Zone{{X: 0, Width: 1000, Kind: ZoneReverseGravity}}
+
+	start := w.Bird.Y
+	for i := 0; i < 10; i++ {
+		w.Step(Input{})
+	}
+	if w.Bird.Y >= start {
+		t.Errorf("bird went from row %v to %v, want it to fall upwards", start, w.Bird.Y)
+	}
+
+	w.Step(Input{Jump: true})
+	if w.Bird.Velocity <= 0 {
+		t.Errorf("velocity %v after a jump, want it to head down", w.Bird.Velocity)
+	}
+
+	// Out of the zone the bird falls down again
+	w.Zones = nil
+	w.Bird.Velocity = 0
+	w.Step(Input{})
+	if w.Bird.Velocity <= 0 {
+		t.Errorf("velocity %v outside the zone, want it to fall down", w.Bird.Velocity)
+	}
+}
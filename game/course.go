@@ -0,0 +1,74 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// LoadCourse loads and validates a course file
+func LoadCourse(path string) (*domain.Course, error) {
+	f, err := storage.LoadCourse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := courseFromFile(f)
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid course %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// courseFromFile fills in the defaults of a course file
+func courseFromFile(f *storage.Course) *domain.Course {
+	c := &domain.Course{
+		Name:   f.Name,
+		Height: f.Height,
+		End:    domain.DefaultCourseEnd,
+	}
+	if c.Height == 0 {
+		c.Height = domain.DefaultCourseHeight
+	}
+	if f.End != nil {
+		c.End = f.End.Spacing
+	}
+
+	for _, p := range f.Pipes {
+		width := p.Width
+		if width == 0 {
+			width = domain.PipeWidth
+		}
+		c.Pipes = append(c.Pipes, domain.CoursePipe{
+			Spacing: p.Spacing,
+			GapY:    p.GapY,
+			GapSize: p.GapSize,
+			Width:   width,
+		})
+	}
+	return c
+}
+
+// courseToFile converts a course to its file format, leaving out default widths
+func courseToFile(c *domain.Course) storage.Course {
+	f := storage.Course{
+		Name:   c.Name,
+		Height: c.Height,
+		Pipes:  []storage.CoursePipe{},
+		End:    &storage.CourseEnd{Spacing: c.End},
+	}
+	for _, p := range c.Pipes {
+		width := p.Width
+		if width == domain.PipeWidth {
+			width = 0
+		}
+		f.Pipes = append(f.Pipes, storage.CoursePipe{
+			Spacing: p.Spacing,
+			GapY:    p.GapY,
+			GapSize: p.GapSize,
+			Width:   width,
+		})
+	}
+	return f
+}
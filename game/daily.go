@@ -0,0 +1,79 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// startDaily starts today's daily challenge with its fixed settings
+func (m Model) startDaily() (Model, tea.Cmd) {
+	if m.Width < domain.DailyWidth || m.Height < domain.DailyHeight {
+		m.Notice = fmt.Sprintf("The daily challenge needs a %dx%d terminal", domain.DailyWidth, domain.DailyHeight)
+		return m, nil
+	}
+
+	m.DailyDate = domain.DailyDate(time.Now())
+	m.Mode = domain.ModeClassic
+	m.Difficulty = domain.DailyDifficulty
+	m.Hitbox = domain.DailyHitbox
+	m.Notice = ""
+	m = m.resetGame()
+	return m, tick(frameInterval)
+}
+
+// handleDailyGameOver records the first daily run of the day on that day's board
+// The run was put on the board as abandoned when it started, and now gets its result
+func (m Model) handleDailyGameOver(result storage.HighScore) Model {
+	if !m.Counted {
+		return m
+	}
+	if boards, _, err := m.Store.AddDailyResult(m.DailyDate, storage.DailyResult{HighScore: result}); err == nil {
+		m.DailyBoards = boards
+	}
+	return m
+}
+
+// showDailyBoard opens the daily results browser on the most recent day
+func (m Model) showDailyBoard() Model {
+	dates := storage.DailyDates(m.DailyBoards)
+	if len(dates) == 0 {
+		m.Notice = "No daily results yet - press D to play today's challenge"
+		return m
+	}
+	m.State = StateDailyBoard
+	m.BoardDate = dates[len(dates)-1]
+	m.Notice = ""
+	return m
+}
+
+// handleDailyBoardKey moves between days while browsing daily results
+func (m Model) handleDailyBoardKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	dates := storage.DailyDates(m.DailyBoards)
+	current := 0
+	for i, d := range dates {
+		if d == m.BoardDate {
+			current = i
+		}
+	}
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "left", "h":
+		if current > 0 {
+			m.BoardDate = dates[current-1]
+		}
+	case "right", "l":
+		if current < len(dates)-1 {
+			m.BoardDate = dates[current+1]
+		}
+	case "esc", "enter", " ":
+		m.State = StateTitle
+	}
+
+	return m, nil
+}
@@ -0,0 +1,73 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+func TestServerSessionsShareBoards(t *testing.T) {
+	server := storage.ServerStore(t.TempDir())
+	session := func(name string) Model {
+		store, err := server.ForPlayer(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return NewModel(Options{Seed: 1, Store: store, Quiet: true})
+	}
+
+	// Both players open the game before either finishes a run
+	ann, bob := session("ann"), session("bob")
+	ann.DailyDate, bob.DailyDate = "2026-10-16", "2026-10-16"
+	ann, bob = ann.resetGame(), bob.resetGame()
+	ann = ann.handleDailyGameOver(storage.HighScore{Score: 5})
+	bob = bob.handleDailyGameOver(storage.HighScore{Score: 3})
+
+	if !ann.Counted || !bob.Counted {
+		t.Fatalf("counted ann = %v, bob = %v", ann.Counted, bob.Counted)
+	}
+	if board := bob.DailyBoards["2026-10-16"]; len(board) != 2 || board[0].Player != "ann" {
+		t.Errorf("bob sees daily board %+v, want ann ahead of bob", board)
+	}
+
+	// A new session sees everyone's results
+	if board := session("cat").DailyBoards["2026-10-16"]; len(board) != 2 {
+		t.Errorf("new session sees daily board %+v, want both results", board)
+	}
+}
+
+func TestQuitDailyRunStillCounts(t *testing.T) {
+	store := newTestModel(t).Store
+	m := NewModel(Options{Store: store, Quiet: true})
+	m.DailyDate = "2026-10-16"
+	m = m.resetGame()
+	if !m.Counted {
+		t.Fatal("first daily run of the day doesn't count")
+	}
+
+	// Quit before crashing, then come back for another look at the pipes
+	m = NewModel(Options{Store: store, Quiet: true})
+	m.DailyDate = "2026-10-16"
+	m = m.resetGame()
+	if m.Counted {
+		t.Error("daily run after a quit one counts")
+	}
+	m = m.handleDailyGameOver(storage.HighScore{Score: 9})
+
+	board := m.DailyBoards["2026-10-16"]
+	if len(board) != 1 || !board[0].Abandoned || board[0].Score != 0 {
+		t.Errorf("daily board = %+v, want only the abandoned first run", board)
+	}
+}
+
+func TestDailyRunFinishes(t *testing.T) {
+	m := newTestModel(t)
+	m.DailyDate = "2026-10-16"
+	m = m.resetGame()
+	m = m.handleDailyGameOver(storage.HighScore{Score: 9})
+
+	board := m.DailyBoards["2026-10-16"]
+	if len(board) != 1 || board[0].Abandoned || board[0].Score != 9 {
+		t.Errorf("daily board = %+v, want the finished run", board)
+	}
+}
@@ -0,0 +1,353 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+const (
+	newPipeSpacing = 30                     // Spacing of pipes added in the editor
+	reachDelay     = 300 * time.Millisecond // Pause after an edit before checking reachability
+	scrollMargin   = 2                      // Cells kept between the selected pipe and the screen edge
+)
+
+// Editor holds the state of the course editor
+type Editor struct {
+	Path        string         // Course file being edited
+	Course      *domain.Course // Course being edited, replaced on every edit
+	Cursor      int            // Selected pipe, len(Course.Pipes) selects the finish line
+	Scroll      int            // Course position shown at the bird's start column
+	Modified    bool           // Whether there are unsaved changes
+	Unreachable int            // First pipe the bird can't get through, -1 if all can be reached
+	Checking    bool           // Whether the reachability of the current course is still being checked
+	Message     string         // Result of the last action
+	confirmQuit bool           // Quit was pressed once with unsaved changes
+}
+
+// reachCheckMsg starts a reachability check once edits have settled
+type reachCheckMsg struct {
+	course *domain.Course
+}
+
+// reachResultMsg carries the result of a reachability check
+type reachResultMsg struct {
+	course      *domain.Course
+	difficulty  domain.Difficulty
+	unreachable int
+}
+
+// playTestMsg carries the reachability check of the part of a course about to be play-tested
+type playTestMsg struct {
+	course      *domain.Course // Course being edited when the play-test was asked for
+	from        *domain.Course // Part of it the play-test starts with
+	start       int            // Index of the first pipe of from in course
+	unreachable int
+}
+
+// NewEditorModel creates a game that edits the course file at path
+// A missing file starts a new course that is written on the first save
+func NewEditorModel(path string, opts Options) (Model, error) {
+	m := NewModel(opts)
+
+	course, err := LoadCourse(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		course = newCourse()
+	} else if err != nil {
+		return m, err
+	}
+
+	m.State = StateEditor
+	m.Editor = &Editor{
+		Path:        path,
+		Course:      course,
+		Unreachable: -1,
+		Checking:    true,
+	}
+	return m, nil
+}
+
+// newCourse returns a course with a single pipe to start editing from
+func newCourse() *domain.Course {
+	return &domain.Course{
+		Height: domain.DefaultCourseHeight,
+		Pipes: []domain.CoursePipe{
+			{Spacing: newPipeSpacing, GapY: 6, GapSize: 12, Width: domain.PipeWidth},
+		},
+		End: domain.DefaultCourseEnd,
+	}
+}
+
+// handleEditorKey handles key presses in the course editor
+func (m Model) handleEditorKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	e := m.Editor
+	key := msg.String()
+
+	// Quitting with unsaved changes needs a second press
+	if key == "q" && e.Modified && !e.confirmQuit {
+		e.confirmQuit = true
+		e.Message = "Unsaved changes - press Q again to quit, S to save"
+		return m, nil
+	}
+	e.confirmQuit = false
+
+	switch key {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case "left":
+		e.Cursor = max(e.Cursor-1, 0)
+	case "right":
+		e.Cursor = min(e.Cursor+1, len(e.Course.Pipes))
+
+	case "up": // Move the gap up
+		return m.editPipe(func(p *domain.CoursePipe) { p.GapY-- })
+	case "down": // Move the gap down
+		return m.editPipe(func(p *domain.CoursePipe) { p.GapY++ })
+
+	case "+", "=": // Grow the gap, upwards once it reaches the floor
+		return m.editPipe(func(p *domain.CoursePipe) {
+			p.GapSize++
+			if p.GapY+p.GapSize > e.Course.Height {
+				p.GapY--
+			}
+		})
+	case "-": // Shrink the gap
+		return m.editPipe(func(p *domain.CoursePipe) { p.GapSize-- })
+
+	case "[": // Narrower pipe
+		return m.editPipe(func(p *domain.CoursePipe) { p.Width-- })
+	case "]": // Wider pipe
+		return m.editPipe(func(p *domain.CoursePipe) { p.Width++ })
+
+	case "<", ",": // Move the selection left
+		return m.move(-1)
+	case ">", ".": // Move the selection right
+		return m.move(1)
+
+	case "a": // Add a pipe after the selection
+		return m.addPipe()
+	case "x", "delete": // Delete the selected pipe
+		return m.deletePipe()
+
+	case "1", "2", "3": // Difficulty used for play-testing and the reachability check
+		switch key {
+		case "1":
+			m.Difficulty = domain.DifficultyEasy
+		case "2":
+			m.Difficulty = domain.DifficultyNormal
+		case "3":
+			m.Difficulty = domain.DifficultyHard
+		}
+		return m, m.checkReachable()
+
+	case "p", "enter": // Play-test from the selection
+		return m.playTest()
+
+	case "s", "ctrl+s":
+		return m.saveCourse(), nil
+	}
+
+	m.follow()
+	return m, nil
+}
+
+// editPipe changes the selected pipe; the finish line has no gap to change
+func (m Model) editPipe(change func(p *domain.CoursePipe)) (Model, tea.Cmd) {
+	i := m.Editor.Cursor
+	if i == len(m.Editor.Course.Pipes) {
+		return m, nil
+	}
+	return m.edit(func(c *domain.Course) { change(&c.Pipes[i]) })
+}
+
+// move shifts the selected pipe or the finish line without moving anything after it
+func (m Model) move(d int) (Model, tea.Cmd) {
+	i := m.Editor.Cursor
+	return m.edit(func(c *domain.Course) {
+		if i == len(c.Pipes) {
+			c.End += d
+			return
+		}
+		c.Pipes[i].Spacing += d
+		if i+1 < len(c.Pipes) {
+			c.Pipes[i+1].Spacing -= d
+		} else {
+			c.End -= d
+		}
+	})
+}
+
+// addPipe inserts a copy of the selected pipe after it, pushing the rest of the course back
+func (m Model) addPipe() (Model, tea.Cmd) {
+	i := min(m.Editor.Cursor, len(m.Editor.Course.Pipes)-1)
+	count := len(m.Editor.Course.Pipes)
+	m, cmd := m.edit(func(c *domain.Course) {
+		p := c.Pipes[i]
+		p.Spacing = newPipeSpacing
+		c.Pipes = slices.Insert(c.Pipes, i+1, p)
+	})
+	if len(m.Editor.Course.Pipes) > count {
+		m.Editor.Cursor = i + 1
+		m.follow()
+	}
+	return m, cmd
+}
+
+// deletePipe removes the selected pipe, keeping everything after it in place
+func (m Model) deletePipe() (Model, tea.Cmd) {
+	i := m.Editor.Cursor
+	if i == len(m.Editor.Course.Pipes) {
+		return m, nil
+	}
+	if len(m.Editor.Course.Pipes) == 1 {
+		m.Editor.Message = "A course needs at least one pipe"
+		return m, nil
+	}
+
+	m, cmd := m.edit(func(c *domain.Course) {
+		spacing := c.Pipes[i].Spacing
+		c.Pipes = slices.Delete(c.Pipes, i, i+1)
+		if i < len(c.Pipes) {
+			c.Pipes[i].Spacing += spacing
+		} else {
+			c.End += spacing
+		}
+	})
+	m.Editor.Cursor = min(i, len(m.Editor.Course.Pipes))
+	m.follow()
+	return m, cmd
+}
+
+// edit applies a change to a copy of the course and keeps it if the course is still valid
+func (m Model) edit(change func(c *domain.Course)) (Model, tea.Cmd) {
+	e := m.Editor
+	c := e.Course.Clone()
+	change(c)
+	if err := c.Validate(); err != nil {
+		e.Message = err.Error()
+		return m, nil
+	}
+
+	e.Course = c
+	e.Modified = true
+	e.Message = ""
+	e.Checking = true
+	m.follow()
+
+	// Wait for edits to settle so holding a key doesn't start a check per repeat
+	return m, tea.Tick(reachDelay, func(time.Time) tea.Msg {
+		return reachCheckMsg{course: c}
+	})
+}
+
+// checkReachable returns a command that checks whether every gap of the course can be reached
+func (m Model) checkReachable() tea.Cmd {
+	c := m.Editor.Course
+	m.Editor.Checking = true
+	difficulty, hitbox := m.Difficulty, m.Hitbox
+	return func() tea.Msg {
+		return reachResultMsg{course: c, difficulty: difficulty, unreachable: c.FirstUnreachable(difficulty, hitbox)}
+	}
+}
+
+// handleReachMsg starts or records reachability checks that still match the edited course
+func (m Model) handleReachMsg(msg tea.Msg) (Model, tea.Cmd) {
+	if m.Editor == nil {
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case reachCheckMsg:
+		if msg.course == m.Editor.Course {
+			return m, m.checkReachable()
+		}
+	case reachResultMsg:
+		if msg.course == m.Editor.Course && msg.difficulty == m.Difficulty {
+			m.Editor.Unreachable = msg.unreachable
+			m.Editor.Checking = false
+		}
+	}
+	return m, nil
+}
+
+// follow scrolls the editor so the selection stays on screen
+func (m Model) follow() {
+	e := m.Editor
+	pos := e.Course.Position(e.Cursor)
+	width := domain.PipeWidth
+	if e.Cursor < len(e.Course.Pipes) {
+		width = e.Course.Pipes[e.Cursor].Width
+	}
+
+	x := domain.BirdStartX + pos - e.Scroll
+	if x < scrollMargin {
+		e.Scroll = domain.BirdStartX + pos - scrollMargin
+	} else if x+width > m.Width-scrollMargin {
+		e.Scroll = domain.BirdStartX + pos + width + scrollMargin - m.Width
+	}
+	e.Scroll = max(e.Scroll, 0)
+}
+
+// playTest starts a run of the course from the selected pipe
+func (m Model) playTest() (Model, tea.Cmd) {
+	e := m.Editor
+	if m.Height < e.Course.Height {
+		e.Message = fmt.Sprintf("Play-testing needs a terminal at least %d rows high", e.Course.Height)
+		return m, nil
+	}
+
+	// Starting part way in moves the first pipe up to the bird, which may make the course invalid or impossible
+	start := min(e.Cursor, len(e.Course.Pipes)-1)
+	from := e.Course.From(start)
+	if err := from.Validate(); err != nil {
+		e.Message = fmt.Sprintf("Can't play-test from pipe %d: %v", start+1, err)
+		return m, nil
+	}
+
+	e.Message = "Checking the course..."
+	course, difficulty, hitbox := e.Course, m.Difficulty, m.Hitbox
+	return m, func() tea.Msg {
+		return playTestMsg{course: course, from: from, start: start, unreachable: from.FirstUnreachable(difficulty, hitbox)}
+	}
+}
+
+// handlePlayTestMsg starts a play-test once its course is known to be reachable
+// Results for a course that was edited or left in the meantime are dropped
+func (m Model) handlePlayTestMsg(msg playTestMsg) (Model, tea.Cmd) {
+	e := m.Editor
+	if e == nil || m.State != StateEditor || msg.course != e.Course {
+		return m, nil
+	}
+	if msg.unreachable >= 0 {
+		e.Message = fmt.Sprintf("Can't play-test from pipe %d: pipe %d can't be reached", msg.start+1, msg.start+msg.unreachable+1)
+		return m, nil
+	}
+
+	e.Message = ""
+	m.Course = msg.from
+	m = m.resetGame()
+	return m, tick(frameInterval)
+}
+
+// saveCourse writes the course to its file
+func (m Model) saveCourse() Model {
+	e := m.Editor
+	if err := storage.SaveCourse(e.Path, courseToFile(e.Course)); err != nil {
+		e.Message = fmt.Sprintf("Save failed: %v", err)
+		return m
+	}
+
+	e.Modified = false
+	e.Message = "Saved " + e.Path
+	if !e.Checking && e.Unreachable >= 0 {
+		e.Message += fmt.Sprintf(" - pipe %d can't be reached", e.Unreachable+1)
+	}
+	return m
+}
@@ -0,0 +1,140 @@
+package game
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// newTestEditor opens the editor on a course with the given pipes
+func newTestEditor(t *testing.T, pipes ...domain.CoursePipe) Model {
+	t.Helper()
+	dir := t.TempDir()
+	m, err := NewEditorModel(filepath.Join(dir, "course.json"), Options{Store: &storage.Store{Dir: dir}, Quiet: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Editor.Course = &domain.Course{Height: domain.DefaultCourseHeight, Pipes: pipes, End: domain.DefaultCourseEnd}
+	if err := m.Editor.Course.Validate(); err != nil {
+		t.Fatalf("test course is invalid: %v", err)
+	}
+	return m
+}
+
+// playTestFrom selects pipe i, asks for a play-test and runs the check it starts
+func playTestFrom(m Model, i int) Model {
+	m.Editor.Cursor = i
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if cmd != nil {
+		m, _ = m.Update(cmd())
+	}
+	return m
+}
+
+func TestPlayTestChecksPartialCourse(t *testing.T) {
+	open := domain.CoursePipe{Spacing: 30, GapY: 6, GapSize: 12, Width: domain.PipeWidth}
+	thin := domain.CoursePipe{Spacing: 30, GapY: 6, GapSize: 12, Width: 1}
+
+	tests := []struct {
+		name        string
+		pipes       []domain.CoursePipe
+		from        int
+		wantPlaying bool
+		wantMessage string
+	}{
+		{name: "whole course", pipes: []domain.CoursePipe{open, open}, from: 0, wantPlaying: true},
+		{name: "reachable part", pipes: []domain.CoursePipe{open, open}, from: 1, wantPlaying: true},
+		{
+			name:        "first pipe on top of the bird",
+			pipes:       []domain.CoursePipe{thin, {Spacing: 1, GapY: 6, GapSize: 12, Width: 1}},
+			from:        1,
+			wantMessage: "Can't play-test from pipe 2: pipe 1: spacing",
+		},
+		{
+			name:        "gap out of reach",
+			pipes:       []domain.CoursePipe{open, {Spacing: 10, GapY: 0, GapSize: 2, Width: domain.PipeWidth}},
+			from:        1,
+			wantMessage: "Can't play-test from pipe 2: pipe 2 can't be reached",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := playTestFrom(newTestEditor(t, tt.pipes...), tt.from)
+
+			if playing := m.State == StatePlaying; playing != tt.wantPlaying {
+				t.Fatalf("playing = %v, want %v (message %q)", playing, tt.wantPlaying, m.Editor.Message)
+			}
+			if tt.wantPlaying && len(m.Course.Pipes) != len(tt.pipes)-tt.from {
+				t.Errorf("play-test has %d pipes, want %d", len(m.Course.Pipes), len(tt.pipes)-tt.from)
+			}
+			if !strings.HasPrefix(m.Editor.Message, tt.wantMessage) {
+				t.Errorf("message = %q, want it to start with %q", m.Editor.Message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestPlayTestDroppedAfterEdit(t *testing.T) {
+	open := domain.CoursePipe{Spacing: 30, GapY: 6, GapSize: 12, Width: domain.PipeWidth}
+	m := newTestEditor(t, open, open)
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	result := cmd()
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown}) // Edit before the check is back
+	m, _ = m.Update(result)
+
+	if m.State != StateEditor {
+		t.Errorf("play-test of an outdated course started")
+	}
+}
+
+func TestQuitDuringPlayTestKeepsEdits(t *testing.T) {
+	open := domain.CoursePipe{Spacing: 30, GapY: 6, GapSize: 12, Width: domain.PipeWidth}
+	quit := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}
+
+	tests := []struct {
+		name     string
+		modified bool
+		over     bool
+		wantQuit bool
+	}{
+		{name: "saved course", wantQuit: true},
+		{name: "unsaved while playing", modified: true},
+		{name: "unsaved after the run", modified: true, over: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestEditor(t, open, open)
+			m.Editor.Modified = tt.modified
+			m = playTestFrom(m, 0)
+			if m.State != StatePlaying {
+				t.Fatalf("play-test didn't start: %q", m.Editor.Message)
+			}
+			if tt.over {
+				m.State = StateGameOver
+			}
+
+			m, cmd := m.Update(quit)
+			if quitting := cmd != nil; quitting != tt.wantQuit {
+				t.Fatalf("quit = %v, want %v", quitting, tt.wantQuit)
+			}
+			if tt.wantQuit {
+				return
+			}
+			if m.State != StateEditor || !strings.Contains(m.Editor.Message, "Unsaved changes") {
+				t.Errorf("state %v with message %q, want the editor asking about unsaved changes", m.State, m.Editor.Message)
+			}
+
+			// A second press quits
+			if _, cmd := m.Update(quit); cmd == nil {
+				t.Error("second Q didn't quit")
+			}
+		})
+	}
+}
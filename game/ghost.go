@@ -0,0 +1,66 @@
+package game
+
+import (
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// Ghost replays the personal best on the current seed alongside the live run
+type Ghost struct {
+	World *domain.World
+	Tape  *domain.InputTape
+	Best  storage.HighScore // Ranking entry the ghost comes from
+}
+
+// loadGhost finds the best ranked run with the same seed and settings as the current world
+// Returns nil when there is no matching run with a replay
+func (m Model) loadGhost() *Ghost {
+	if !m.Mode.Ranked() || m.Playback != nil || m.World.Course != nil || m.Tournament != nil {
+		return nil
+	}
+
+	// Rankings are sorted best first, so the first match is the personal best
+	// A shared store's rankings hold everyone's scores, so only the player's own count
+	for _, hs := range m.Leaderboard() {
+		if m.Store.Shared() && hs.Player != m.Store.Player {
+			continue
+		}
+		if hs.Replay == "" || hs.Seed != m.World.Seed ||
+			hs.Difficulty != m.World.Difficulty.String() || hs.Hitbox != m.World.Hitbox.String() {
+			continue
+		}
+
+		path, err := m.Store.ReplayPath(hs.Replay)
+		if err != nil {
+			return nil
+		}
+		r, err := storage.LoadReplay(path)
+		if err != nil || r.Digest() != hs.Digest {
+			continue
+		}
+
+		// Pipes depend on the playfield size, so the ghost only makes sense on the same size
+		cfg := replayConfig(r)
+		if cfg.Width != m.World.Width || cfg.Height != m.World.Height {
+			continue
+		}
+
+		return &Ghost{
+			World: domain.NewWorld(cfg),
+			Tape:  domain.NewInputTape(r.Jumps),
+			Best:  hs,
+		}
+	}
+
+	return nil
+}
+
+// step advances the ghost in lockstep with the live world
+func (g *Ghost) step() {
+	g.World.Step(g.Tape.Next(g.World.Tick))
+}
+
+// ScoreDiff returns how far the live run is ahead of the ghost
+func (m Model) ScoreDiff() int {
+	return m.World.Score - m.Ghost.World.Score
+}
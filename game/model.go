@@ -13,9 +13,41 @@ type GameState int
 const (
 	StateTitle GameState = iota
 	StatePlaying
+	StatePractice // Playing a practice run, which respawns instead of ending
 	StateGameOver
+	StateDailyBoard      // Browsing past daily challenge results
+	StateEditor          // Editing a course file
+	StateShop            // Spending coins on cosmetics
+	StateTournamentSetup // Entering the players of a hot-seat tournament
+	StateTournament      // Tournament table shown between turns
 )
 
+// Options holds settings chosen on the command line
+type Options struct {
+	Seed    uint64         // Fixed pipe seed for every run, 0 picks a new random seed per run
+	Course  *domain.Course // Handcrafted course loaded with --course, nil for random pipes
+	Version string         // Build version stored in replays
+	P2Key   string         // Key player two jumps with in versus mode, DefaultP2Key if empty
+	Store   *storage.Store // Where scores and progress are saved, the running user's home if nil
+	Quiet   bool           // Never ring the bell, for players whose terminal is on another machine
+
+	Difficulty string // Difficulty picked with --difficulty, Normal if empty
+	Hitbox     string // Hitbox mode picked with --hitbox, Precise if empty
+	PowerUps   bool   // Power-ups switched on with --power-ups
+}
+
+// settings returns the difficulty and hitbox mode the options pick
+func (o Options) settings() (domain.Difficulty, domain.HitboxMode) {
+	difficulty, hitbox := domain.DifficultyNormal, domain.HitboxPrecise
+	if o.Difficulty != "" {
+		difficulty = domain.ParseDifficulty(o.Difficulty)
+	}
+	if o.Hitbox != "" {
+		hitbox = domain.ParseHitboxMode(o.Hitbox)
+	}
+	return difficulty, hitbox
+}
+
 // Stats holds game statistics
 type Stats struct {
 	JumpCount     int // Number of jumps
@@ -27,53 +59,176 @@ type Stats struct {
 
 // Model holds the entire game state
 type Model struct {
-	State       GameState
-	Bird        *domain.Bird
-	Pipes       []*domain.Pipe
-	Score       int
-	Width       int
-	Height      int
-	GameSpeed   time.Duration
-	StartTime   time.Time // Game start time for elapsed time display
-	HighScore   *storage.HighScore
-	Rankings    []storage.HighScore // Top 10 rankings
-	IsNewRecord bool                // Flag to indicate if current game is a new high score
-	Difficulty  domain.Difficulty   // Current difficulty level
-	Theme       domain.Theme        // Current color theme
-	Stats       Stats               // Game statistics
-	Err         error
+	State        GameState
+	World        *domain.World   // Simulation of the current run, player one's in versus mode
+	Players      []*Player       // Everyone sharing the screen in versus mode, nil otherwise
+	Net          *NetMatch       // Race against an opponent over the network, nil for local play
+	P2Key        string          // Key player two jumps with in versus mode
+	FixedSeed    uint64          // Seed requested with --seed, 0 if none
+	Course       *domain.Course  // Course loaded with --course, nil for random pipes
+	Editor       *Editor         // Course editor, nil unless started with the edit command
+	JumpQueued   bool            // Jump pressed since the last tick
+	History      *domain.History // Recent snapshots for rewinding, practice mode only
+	Held         bool            // World paused after a rewind until the player jumps
+	Ghost        *Ghost          // Personal best on the same seed, nil if there is none
+	Recording    storage.Replay  // Inputs of the current run, saved on game over
+	Store        *storage.Store  // Where scores and progress are saved
+	Quiet        bool            // Whether sounds are muted
+	Version      string          // Build version stored in replays
+	Playback     *Playback       // Set when watching a replay instead of playing
+	Width        int             // Terminal width
+	Height       int             // Terminal height
+	LastFrame    time.Time       // Time of the previous frame tick
+	Lag          time.Duration   // Real time not yet simulated
+	HighScore    *storage.HighScore
+	Rankings     []storage.HighScore            // Top 10 rankings
+	ModeRankings map[string][]storage.HighScore // Top 10 of each other mode, keyed by mode
+	IsNewRecord  bool                           // Flag to indicate if current game is a new high score
+	Mode         domain.Mode                    // Current game mode
+	Target       int                            // Pipes to pass in speedrun mode
+	PowerUps     bool                           // Whether power-up items spawn, ranked separately
+	BestSplits   map[string][]time.Duration     // Splits of the fastest speedruns, keyed by leaderboard name
+	PBSplits     []time.Duration                // Best splits the current speedrun is compared against
+	Difficulty   domain.Difficulty              // Current difficulty level
+	Hitbox       domain.HitboxMode              // Current collision hitbox mode
+	Theme        domain.Theme                   // Current color theme
+	Sprite       domain.Sprite                  // Bird appearance, never changes the hitbox
+	Wallet       *storage.Wallet                // Coin balance and cosmetics bought with it
+	ShopCursor   int                            // Selected item in the shop
+	Tournament   *Tournament                    // Hot-seat tournament being set up or played, nil otherwise
+	Tournaments  []storage.Tournament           // Finished tournaments, oldest first
+	Stats        Stats                          // Game statistics
+	DailyDate    string                         // Date of the daily challenge being played, empty otherwise
+	DailyBoards  map[string][]storage.DailyResult
+	Counted      bool   // Whether the daily run is the one that counts
+	BoardDate    string // Date shown while browsing daily results
+	Notice       string // Message shown on the title screen or in the shop
+	Err          error
 }
 
 // NewModel creates a new game with default values
-func NewModel() Model {
+func NewModel(opts Options) Model {
 	width := 80
 	height := 24
 
+	store := opts.Store
+	if store == nil {
+		store = storage.Home()
+	}
+
 	// Load high score
-	highScore, err := storage.LoadHighScore()
+	highScore, err := store.LoadHighScore()
 	if err != nil {
 		highScore = &storage.HighScore{} // Use empty high score on error
 	}
 
 	// Load rankings
-	rankings, err := storage.LoadRankings()
+	rankings, err := store.LoadRankings()
 	if err != nil {
 		rankings = []storage.HighScore{} // Use empty rankings on error
 	}
 
-	return Model{
-		State:      StateTitle,
-		Bird:       domain.NewBird(10, height/2),
-		Pipes:      []*domain.Pipe{},
-		Score:      0,
-		Width:      width,
-		Height:     height,
-		GameSpeed:  time.Millisecond * 45, // ~22 FPS - faster scroll speed
-		HighScore:  highScore,
-		Rankings:   rankings,
-		Difficulty: domain.DifficultyNormal, // Default difficulty
-		Theme:      domain.ThemeClassic,     // Default theme
+	// Load rankings of the other modes
+	modeRankings, err := store.LoadModeRankings()
+	if err != nil {
+		modeRankings = map[string][]storage.HighScore{} // Use empty rankings on error
+	}
+
+	// Load speedrun splits
+	bestSplits, err := store.LoadBestSplits()
+	if err != nil {
+		bestSplits = map[string][]time.Duration{} // Use empty splits on error
+	}
+
+	// Load daily challenge results
+	dailyBoards, err := store.LoadDailyBoards()
+	if err != nil {
+		dailyBoards = map[string][]storage.DailyResult{} // Use empty boards on error
+	}
+
+	// Load tournament history
+	tournaments, err := store.LoadTournaments()
+	if err != nil {
+		tournaments = []storage.Tournament{} // Use empty history on error
+	}
+
+	// Load coins and cosmetics
+	wallet, err := store.LoadWallet()
+	if err != nil {
+		wallet = &storage.Wallet{} // Use an empty wallet on error
+	}
+
+	difficulty, hitbox := opts.settings()
+
+	m := Model{
+		State:        StateTitle,
+		FixedSeed:    opts.Seed,
+		Course:       opts.Course,
+		Version:      opts.Version,
+		P2Key:        opts.P2Key,
+		Store:        store,
+		Quiet:        opts.Quiet,
+		Width:        width,
+		Height:       height,
+		HighScore:    highScore,
+		Rankings:     rankings,
+		ModeRankings: modeRankings,
+		BestSplits:   bestSplits,
+		DailyBoards:  dailyBoards,
+		Tournaments:  tournaments,
+		Mode:         domain.ModeClassic,        // Default mode
+		Target:       domain.SpeedrunTargets[0], // Default speedrun target
+		Difficulty:   difficulty,                // Normal unless picked with --difficulty
+		Hitbox:       hitbox,                    // Precise unless picked with --hitbox
+		PowerUps:     opts.PowerUps,             // Off unless switched on with --power-ups
+		Theme:        domain.ThemeClassic,       // Default theme
+		Sprite:       domain.DefaultSprite,      // Default bird
+		Wallet:       wallet,
+	}
+
+	if m.P2Key == "" {
+		m.P2Key = DefaultP2Key
+	}
+
+	// Keep the cosmetics equipped in the shop, as long as they are still owned
+	if s := domain.SpriteByName(wallet.Sprite); m.Owns(spriteItem(s)) {
+		m.Sprite = s
+	}
+	if t := domain.ParseTheme(wallet.Theme); m.Owns(themeItem(t)) {
+		m.Theme = t
+	}
+	return m
+}
+
+// Leaderboard returns the rankings the current mode is scored on
+func (m Model) Leaderboard() []storage.HighScore {
+	if m.Mode == domain.ModeClassic && !m.PowerUps {
+		return m.Rankings
+	}
+	return m.ModeRankings[m.LeaderboardName()]
+}
+
+// LeaderboardName returns the name of the leaderboard the current mode is scored on
+// Speedruns are ranked separately for every target and difficulty, and power-up runs apart from the rest
+func (m Model) LeaderboardName() string {
+	return leaderboardName(m.Mode, m.Target, m.Difficulty, m.PowerUps)
+}
+
+// leaderboardName returns the name of the leaderboard runs with the given settings are scored on
+func leaderboardName(mode domain.Mode, target int, difficulty domain.Difficulty, powerUps bool) string {
+	name := mode.String()
+	if mode == domain.ModeSpeedrun {
+		name = speedrunKey(target, difficulty)
+	}
+	if powerUps {
+		name += " Power-ups"
 	}
+	return name
+}
+
+// Running reports whether a run is in progress
+func (m Model) Running() bool {
+	return m.State == StatePlaying || m.State == StatePractice
 }
 
 // AvgHeight calculates the average height from statistics
@@ -84,30 +239,141 @@ func (m Model) AvgHeight() float64 {
 	return 0.0
 }
 
+// worldConfig returns the config for a new run with the current settings
+func (m Model) worldConfig(seed uint64) domain.Config {
+	cfg := domain.Config{
+		Seed:       seed,
+		Mode:       m.Mode,
+		Difficulty: m.Difficulty,
+		Hitbox:     m.Hitbox,
+		PowerUps:   m.PowerUps,
+		Width:      m.Width,
+		Height:     m.Height,
+	}
+	if m.Mode == domain.ModeSpeedrun {
+		cfg.Target = m.Target
+	}
+
+	// Everyone plays the daily challenge on the same playfield
+	if m.DailyDate != "" {
+		cfg.Width = domain.DailyWidth
+		cfg.Height = domain.DailyHeight
+		cfg.PowerUps = false
+	} else if m.Course != nil {
+		// Course gaps are laid out for the course's height
+		cfg.Course = m.Course
+		cfg.Height = m.Course.Height
+	}
+
+	// Players in versus mode share the screen side by side, each playfield above its status line
+	if m.Mode == domain.ModeVersus {
+		cfg.Width = VersusWidth(m.Width)
+		if m.Course == nil {
+			cfg.Height = m.Height - 1
+		}
+	}
+	return cfg
+}
+
 // resetGame resets the game to initial playing state
 func (m Model) resetGame() Model {
-	settings := m.Difficulty.GetSettings()
-
-	return Model{
-		State:       StatePlaying,
-		Bird:        domain.NewBird(10, m.Height/2),
-		Pipes:       []*domain.Pipe{}, // Start with no pipes - gives player time to adjust
-		Score:       0,
-		Width:       m.Width,
-		Height:      m.Height,
-		GameSpeed:   settings.InitialSpeed, // Use difficulty-based speed
-		StartTime:   time.Now(),            // Record game start time
-		HighScore:   m.HighScore,
-		Rankings:    m.Rankings,
-		IsNewRecord: false,
-		Difficulty:  m.Difficulty,
-		Theme:       m.Theme,
+	// Use the requested seed so the run can be repeated exactly
+	seed := m.FixedSeed
+	if m.DailyDate != "" {
+		seed = domain.DailySeed(m.DailyDate)
+	} else if m.Tournament != nil {
+		seed = m.Tournament.Seed
+	} else if seed == 0 {
+		seed = domain.NewSeed()
+	}
+	cfg := m.worldConfig(seed)
+
+	// Start recording inputs for the replay file
+	recording := storage.Replay{
+		Version:    m.Version,
+		Seed:       seed,
+		Mode:       cfg.Mode.String(),
+		Difficulty: cfg.Difficulty.String(),
+		Hitbox:     cfg.Hitbox.String(),
+		Target:     cfg.Target,
+		PowerUps:   cfg.PowerUps,
+		Width:      cfg.Width,
+		Height:     cfg.Height,
+		Jumps:      []int{},
+	}
+
+	// Keep snapshots for rewinding in practice mode
+	world := domain.NewWorld(cfg)
+	var history *domain.History
+	if m.Mode == domain.ModePractice {
+		history = domain.NewHistory(historySize)
+		history.Push(world.Snapshot())
+	}
+
+	// Every player in versus mode gets their own world on the same pipes
+	var players []*Player
+	if m.Mode == domain.ModeVersus {
+		players = m.newPlayers(cfg)
+		world = players[0].World
+	}
+
+	state := StatePlaying
+	if m.Mode == domain.ModePractice {
+		state = StatePractice
+	}
+
+	next := Model{
+		State:        state,
+		World:        world,
+		Players:      players,
+		P2Key:        m.P2Key,
+		Tournament:   m.Tournament,
+		Tournaments:  m.Tournaments,
+		History:      history,
+		FixedSeed:    m.FixedSeed,
+		Course:       m.Course,
+		Editor:       m.Editor,
+		Recording:    recording,
+		Store:        m.Store,
+		Quiet:        m.Quiet,
+		Version:      m.Version,
+		Width:        m.Width,
+		Height:       m.Height,
+		LastFrame:    time.Now(),
+		HighScore:    m.HighScore,
+		Rankings:     m.Rankings,
+		ModeRankings: m.ModeRankings,
+		IsNewRecord:  false,
+		Mode:         m.Mode,
+		Target:       m.Target,
+		PowerUps:     m.PowerUps,
+		BestSplits:   m.BestSplits,
+		PBSplits:     m.BestSplits[m.LeaderboardName()],
+		Difficulty:   m.Difficulty,
+		Hitbox:       m.Hitbox,
+		Theme:        m.Theme,
+		Sprite:       m.Sprite,
+		Wallet:       m.Wallet,
+		DailyDate:    m.DailyDate,
+		DailyBoards:  m.DailyBoards,
 		Stats: Stats{
 			JumpCount:     0,
-			MaxHeight:     m.Height / 2,
-			MinHeight:     m.Height / 2,
+			MaxHeight:     cfg.Height / 2,
+			MinHeight:     cfg.Height / 2,
 			TotalHeight:   0,
 			HeightSamples: 0,
 		},
 	}
+
+	// The first daily run of the day is put on the board as soon as it starts, so quitting it doesn't earn another try
+	if next.DailyDate != "" {
+		if boards, counted, err := next.Store.StartDailyRun(next.DailyDate); err == nil {
+			next.DailyBoards = boards
+			next.Counted = counted
+		}
+	}
+
+	// Race against the personal best on this seed if there is one
+	next.Ghost = next.loadGhost()
+	return next
 }
@@ -0,0 +1,172 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/netplay"
+)
+
+// Network play settings
+const (
+	inputDelay = 3  // Ticks between a local jump and the step it's applied on, hiding the round trip
+	hashEvery  = 60 // Ticks between desync checks
+)
+
+// NetMatch holds a race against an opponent over the network
+// Both sides simulate both worlds in lockstep: a tick is only stepped once the opponent's input for it has arrived
+type NetMatch struct {
+	Peer    *netplay.Peer
+	Tick    int            // Steps simulated so far
+	Waiting bool           // The last step is held up by the opponent's input
+	Err     error          // Why the match was cut short, nil if it wasn't
+	sent    int            // Last tick the local input has been sent for
+	local   map[int]bool   // Local inputs sent but not yet applied, by tick
+	hashes  map[int]uint64 // Own hashes waiting for the opponent's, by tick
+}
+
+// NetSetup returns the settings of a match hosted with the given options
+// Every match is played on the daily challenge's playfield, split between the players
+// and less a row for their status lines, so both sides simulate the same pipes whatever their terminal size
+func NetSetup(opts Options) netplay.Setup {
+	seed := opts.Seed
+	if seed == 0 {
+		seed = domain.NewSeed()
+	}
+	difficulty, hitbox := opts.settings()
+	return netplay.Setup{
+		Seed:       seed,
+		Difficulty: difficulty.String(),
+		Hitbox:     hitbox.String(),
+		PowerUps:   opts.PowerUps,
+		Width:      VersusWidth(domain.DailyWidth),
+		Height:     domain.DailyHeight - 1,
+	}
+}
+
+// NewNetModel creates a game that races an opponent over the network
+// The local player is always on the left
+func NewNetModel(peer *netplay.Peer, opts Options) Model {
+	m := NewModel(opts)
+	s := peer.Setup
+	m.Mode = domain.ModeVersus
+	m.Difficulty = domain.ParseDifficulty(s.Difficulty)
+	m.Hitbox = domain.ParseHitboxMode(s.Hitbox)
+	m.PowerUps = s.PowerUps
+
+	cfg := domain.Config{
+		Seed:       s.Seed,
+		Mode:       m.Mode,
+		Difficulty: m.Difficulty,
+		Hitbox:     m.Hitbox,
+		PowerUps:   m.PowerUps,
+		Width:      s.Width,
+		Height:     s.Height,
+	}
+	m.Players = []*Player{
+		{Name: m.Store.Player, Key: " ", World: domain.NewWorld(cfg)},
+		{Name: peer.Name, World: domain.NewWorld(cfg)},
+	}
+	m.World = m.Players[0].World
+	m.Net = &NetMatch{
+		Peer:   peer,
+		local:  map[int]bool{},
+		hashes: map[int]uint64{},
+	}
+	m.State = StatePlaying
+	m.LastFrame = time.Now()
+	return m
+}
+
+// handleNetKey handles key presses during a network match, which can't be restarted
+func (m Model) handleNetKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case " ":
+		if m.State == StatePlaying {
+			m.Players[0].JumpQueued = true // Sent on the next tick
+		}
+	}
+	return m, nil
+}
+
+// stepNet advances both worlds by one step once the opponent's input for it has arrived
+// Returns false if the step has to wait
+func (m Model) stepNet() (Model, bool) {
+	n := m.Net
+	if err := n.Peer.Err(); err != nil {
+		return m.endNet(err), false
+	}
+	local, remote := m.Players[0], m.Players[1]
+	next := n.Tick + 1
+
+	// Send the local input inputDelay ticks ahead, so it has usually arrived by the time the opponent needs it
+	for n.sent < next+inputDelay {
+		n.sent++
+		jump := false
+		if n.sent == next+inputDelay {
+			jump = local.JumpQueued
+			local.JumpQueued = false
+		}
+		n.local[n.sent] = jump
+		n.Peer.SendInput(n.sent, jump)
+	}
+
+	remoteJump, ok := n.Peer.Input(next)
+	n.Waiting = !ok
+	if !ok {
+		return m, false
+	}
+
+	// Both sides keep stepping until both birds are down, so neither waits for inputs that never come
+	n.Tick = next
+	if !local.World.Over {
+		m.playSounds(local.World.Step(domain.Input{Jump: n.local[next]}))
+	}
+	delete(n.local, next)
+	if !remote.World.Over {
+		remote.World.Step(domain.Input{Jump: remoteJump})
+	}
+
+	// Compare checksums with the opponent now and then
+	if n.Tick%hashEvery == 0 {
+		h := m.netHash()
+		n.hashes[n.Tick] = h
+		n.Peer.SendHash(n.Tick, h)
+	}
+	for tick, h := range n.hashes {
+		theirs, ok := n.Peer.Hash(tick)
+		if !ok {
+			continue
+		}
+		delete(n.hashes, tick)
+		if theirs != h {
+			return m.endNet(fmt.Errorf("desync detected at tick %d", tick)), false
+		}
+	}
+
+	if local.World.Over && remote.World.Over {
+		m.State = StateGameOver
+	}
+	return m, true
+}
+
+// netHash returns the checksum of both worlds, the host's first, which is the same on both sides while they agree
+func (m Model) netHash() uint64 {
+	first, second := m.Players[0].World, m.Players[1].World
+	if !m.Net.Peer.Hosting {
+		first, second = second, first
+	}
+	return first.Hash()*31 + second.Hash()
+}
+
+// endNet stops the match because it can't go on
+func (m Model) endNet(err error) Model {
+	m.Net.Err = err
+	m.State = StateGameOver
+	m.playSound("gameover")
+	return m
+}
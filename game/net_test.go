@@ -0,0 +1,109 @@
+package game
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/netplay"
+)
+
+func TestNetSetupUsesOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want netplay.Setup
+	}{
+		{
+			name: "defaults",
+			opts: Options{Seed: 5},
+			want: netplay.Setup{Seed: 5, Difficulty: "Normal", Hitbox: "Precise"},
+		},
+		{
+			name: "picked settings",
+			opts: Options{Seed: 5, Difficulty: "Hard", Hitbox: "Forgiving", PowerUps: true},
+			want: netplay.Setup{Seed: 5, Difficulty: "Hard", Hitbox: "Forgiving", PowerUps: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.want.Width, tt.want.Height = VersusWidth(domain.DailyWidth), domain.DailyHeight-1
+			if got := NetSetup(tt.opts); got != tt.want {
+				t.Errorf("NetSetup = %+v, want %+v", got, tt.want)
+			}
+
+			// The title screen starts on the same settings
+			m := NewModel(Options{Store: newTestModel(t).Store, Difficulty: tt.opts.Difficulty, Hitbox: tt.opts.Hitbox, PowerUps: tt.opts.PowerUps})
+			if m.Difficulty.String() != tt.want.Difficulty || m.Hitbox.String() != tt.want.Hitbox || m.PowerUps != tt.want.PowerUps {
+				t.Errorf("title screen on %s, %s, power-ups %v", m.Difficulty, m.Hitbox, m.PowerUps)
+			}
+		})
+	}
+}
+
+func TestNetMatchStaysInSync(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	opts := Options{Seed: 3, PowerUps: true, Quiet: true}
+	hosted := make(chan *netplay.Peer)
+	go func() {
+		peer, err := netplay.Host(ln, "ann", "test", NetSetup(opts))
+		if err != nil {
+			t.Error(err)
+		}
+		hosted <- peer
+	}()
+	joined, err := netplay.Join(ln.Addr().String(), "bob", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer joined.Close()
+	host := <-hosted
+	if host == nil {
+		t.FailNow()
+	}
+	defer host.Close()
+
+	opts.Store = newTestModel(t).Store
+	sides := []Model{NewNetModel(host, opts), NewNetModel(joined, opts)}
+
+	// flap keeps a bird near a row, a different one for each side so the birds fly apart
+	flap := func(w *domain.World, i int) bool {
+		return w.Bird.Velocity > 0 && w.Bird.Y > float64(w.Height/2+2*i)
+	}
+
+	// Run both sides until both birds are down
+	deadline := time.Now().Add(10 * time.Second)
+	for sides[0].State == StatePlaying || sides[1].State == StatePlaying {
+		if time.Now().After(deadline) {
+			t.Fatalf("match still running at ticks %d and %d", sides[0].Net.Tick, sides[1].Net.Tick)
+		}
+		for i := range sides {
+			if sides[i].State != StatePlaying {
+				continue
+			}
+			if local := sides[i].Players[0].World; flap(local, i) {
+				sides[i].Players[0].JumpQueued = true
+			}
+			sides[i], _ = sides[i].stepNet()
+		}
+	}
+
+	for i, m := range sides {
+		if m.Net.Err != nil {
+			t.Errorf("side %d: %v", i, m.Net.Err)
+		}
+	}
+	if sides[0].Net.Tick < hashEvery {
+		t.Errorf("match ended at tick %d, before any checksum was compared", sides[0].Net.Tick)
+	}
+	if sides[0].netHash() != sides[1].netHash() {
+		t.Error("sides ended the match in different states")
+	}
+}
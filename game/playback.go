@@ -0,0 +1,116 @@
+package game
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// playbackSpeeds are the selectable playback speed multipliers
+var playbackSpeeds = []float64{0.25, 0.5, 1, 2, 4}
+
+const defaultSpeedIndex = 2 // 1x
+
+// Playback drives a run from a recorded replay instead of the keyboard
+type Playback struct {
+	Replay     *storage.Replay
+	Tape       *domain.InputTape // Recorded jumps still to be played
+	Paused     bool
+	SpeedIndex int // Index into playbackSpeeds
+}
+
+// Speed returns the current playback speed multiplier
+func (p *Playback) Speed() float64 {
+	return playbackSpeeds[p.SpeedIndex]
+}
+
+// NewReplayModel creates a game that plays back a recorded replay
+func NewReplayModel(r *storage.Replay, opts Options) Model {
+	m := NewModel(Options{Seed: r.Seed, Version: opts.Version, Store: opts.Store, Quiet: opts.Quiet})
+	m.Difficulty = domain.ParseDifficulty(r.Difficulty)
+	m.Hitbox = domain.ParseHitboxMode(r.Hitbox)
+	m.Mode = domain.ParseMode(r.Mode)
+	if r.Target > 0 {
+		m.Target = r.Target
+	}
+	m = m.resetGame()
+	m.World = domain.NewWorld(replayConfig(r))
+	m.Ghost = nil
+	m.Playback = &Playback{
+		Replay:     r,
+		Tape:       domain.NewInputTape(r.Jumps),
+		SpeedIndex: defaultSpeedIndex,
+	}
+	return m
+}
+
+// replayConfig returns the world config a replay was recorded with
+// Replays keep the recorded size so the run plays out identically
+func replayConfig(r *storage.Replay) domain.Config {
+	return domain.Config{
+		Seed:       r.Seed,
+		Mode:       domain.ParseMode(r.Mode),
+		Difficulty: domain.ParseDifficulty(r.Difficulty),
+		Hitbox:     domain.ParseHitboxMode(r.Hitbox),
+		Target:     r.Target,
+		PowerUps:   r.PowerUps,
+		Width:      r.Width,
+		Height:     r.Height,
+	}
+}
+
+// handlePlaybackKey handles key presses while watching a replay
+func (m Model) handlePlaybackKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+
+	case " ", "p": // Pause / resume
+		m.Playback.Paused = !m.Playback.Paused
+
+	case "right", ".": // Step a single physics step while paused
+		if m.Playback.Paused && m.Running() {
+			m = m.playbackStep()
+		}
+
+	case "+", "=": // Faster
+		if m.Playback.SpeedIndex < len(playbackSpeeds)-1 {
+			m.Playback.SpeedIndex++
+		}
+
+	case "-": // Slower
+		if m.Playback.SpeedIndex > 0 {
+			m.Playback.SpeedIndex--
+		}
+
+	case "r": // Watch again
+		if m.State == StateGameOver {
+			m = NewReplayModel(m.Playback.Replay, Options{Version: m.Version, Store: m.Store, Quiet: m.Quiet})
+			return m, tick(m.World.GameSpeed)
+		}
+	}
+
+	return m, nil
+}
+
+// updatePlayback advances a replay on every frame unless it is paused
+func (m Model) updatePlayback(now time.Time) (Model, tea.Cmd) {
+	if m.Playback.Paused {
+		m.LastFrame = now
+	} else {
+		m = m.advance(now, m.Playback.Speed())
+	}
+	if !m.Running() {
+		return m, nil
+	}
+
+	// Keep ticking while paused so resuming doesn't start a second tick loop
+	return m, tick(frameInterval)
+}
+
+// playbackStep advances one physics step with the recorded input for that step
+func (m Model) playbackStep() Model {
+	return m.step(m.Playback.Tape.Next(m.World.Tick))
+}
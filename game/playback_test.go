@@ -0,0 +1,55 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+func TestPlaybackThroughRespawns(t *testing.T) {
+	// Record a time attack run that crashes, carrying on after every respawn like a player would
+	m := newTestModel(t)
+	m.Mode = domain.ModeTimeAttack
+	m = m.resetGame()
+	for m.State == StatePlaying {
+		jump := m.World.Tick%30 == 0
+		if m.Held {
+			m.Held = false
+			jump = true
+		}
+		m = m.step(domain.Input{Jump: jump})
+	}
+	if m.World.Crashes == 0 {
+		t.Fatal("the recorded run never respawned")
+	}
+
+	board := m.ModeRankings[m.LeaderboardName()]
+	if len(board) != 1 {
+		t.Fatalf("%d scores ranked after the run, want 1", len(board))
+	}
+	path, err := m.Store.ReplayPath(board[0].Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := storage.LoadReplay(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Watch it frame by frame until it stops
+	pb := NewReplayModel(r, Options{Store: m.Store, Quiet: true})
+	now := pb.LastFrame
+	for frames := 0; pb.Running() && frames < 100000; frames++ {
+		now = now.Add(frameInterval)
+		pb, _ = pb.Update(TickMsg(now))
+	}
+
+	if pb.State != StateGameOver || pb.World.Tick != r.Ticks {
+		t.Fatalf("playback stopped at step %d/%d in state %v, held = %v", pb.World.Tick, r.Ticks, pb.State, pb.Held)
+	}
+	if pb.World.Score != r.Score || pb.World.Crashes != m.World.Crashes {
+		t.Errorf("playback ended with %d pts and %d crashes, recorded %d pts and %d crashes",
+			pb.World.Score, pb.World.Crashes, r.Score, m.World.Crashes)
+	}
+}
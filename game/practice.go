@@ -0,0 +1,45 @@
+package game
+
+import (
+	"time"
+
+	"github.com/takish/flappy-bird-tui/domain"
+)
+
+const (
+	snapshotEvery = 10              // Steps between practice snapshots (150ms)
+	rewindWindow  = 5 * time.Second // How far back practice can rewind
+	rewindStep    = time.Second     // How far a single rewind goes back
+
+	historySize     = int(rewindWindow / (snapshotEvery * domain.StepDuration))
+	rewindSnapshots = int(rewindStep / (snapshotEvery * domain.StepDuration))
+)
+
+// recordSnapshot keeps a snapshot of the world every few steps in practice mode
+func (m Model) recordSnapshot() Model {
+	if m.History != nil && !m.World.Over && m.World.Tick%snapshotEvery == 0 {
+		m.History.Push(m.World.Snapshot())
+	}
+	return m
+}
+
+// rewind restores the world to about a second ago and holds it until the player jumps
+func (m Model) rewind() Model {
+	if m.History == nil {
+		return m
+	}
+	snap, ok := m.History.Back(rewindSnapshots)
+	if !ok {
+		return m
+	}
+	m.World.Restore(snap)
+	m.Held = true
+	m.JumpQueued = false
+	return m
+}
+
+// toggleInvincible switches collisions off or back on for the practice run
+func (m Model) toggleInvincible() Model {
+	m.World.Invincible = !m.World.Invincible
+	return m
+}
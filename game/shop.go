@@ -0,0 +1,148 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// ShopItem is a cosmetic offered in the shop
+type ShopItem struct {
+	ID     string // Name stored in the wallet once bought
+	Name   string
+	Price  int            // Coins needed to unlock it, 0 if always available
+	Sprite *domain.Sprite // Bird sprite, nil for themes
+	Theme  domain.Theme   // Theme, used when Sprite is nil
+}
+
+// ShopItems returns everything in the shop, bird sprites first
+func ShopItems() []ShopItem {
+	var items []ShopItem
+	for _, s := range domain.Sprites {
+		items = append(items, spriteItem(s))
+	}
+	for t := domain.ThemeClassic; ; {
+		items = append(items, themeItem(t))
+		if t = t.Next(); t == domain.ThemeClassic {
+			break
+		}
+	}
+	return items
+}
+
+// spriteItem returns the shop item of a bird sprite
+func spriteItem(s domain.Sprite) ShopItem {
+	return ShopItem{ID: "sprite:" + s.Name, Name: s.Name, Price: s.Price, Sprite: &s}
+}
+
+// themeItem returns the shop item of a theme
+func themeItem(t domain.Theme) ShopItem {
+	return ShopItem{ID: "theme:" + t.String(), Name: t.String(), Price: t.Price(), Theme: t}
+}
+
+// Owns reports whether an item is free or has been bought
+func (m Model) Owns(item ShopItem) bool {
+	return item.Price == 0 || m.Wallet.Owns(item.ID)
+}
+
+// Equipped reports whether an item is the sprite or theme in use
+func (m Model) Equipped(item ShopItem) bool {
+	if item.Sprite != nil {
+		return item.Sprite.Name == m.Sprite.Name
+	}
+	return item.Theme == m.Theme
+}
+
+// openShop shows the shop from the title screen
+func (m Model) openShop() Model {
+	m.State = StateShop
+	m.ShopCursor = 0
+	m.Notice = ""
+	return m
+}
+
+// handleShopKey handles key presses in the shop
+func (m Model) handleShopKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	items := ShopItems()
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "up", "k":
+		m.ShopCursor = max(m.ShopCursor-1, 0)
+	case "down", "j":
+		m.ShopCursor = min(m.ShopCursor+1, len(items)-1)
+	case " ", "enter": // Buy the selected item, or equip it if it is already owned
+		item := items[m.ShopCursor]
+		if !m.Owns(item) {
+			return m.buy(item), nil
+		}
+		m = m.equip(item)
+		m.Notice = item.Name + " equipped"
+	case "esc":
+		m.State = StateTitle
+		m.Notice = ""
+	}
+	return m, nil
+}
+
+// buy spends coins on an item and equips it
+func (m Model) buy(item ShopItem) Model {
+	if m.Wallet.Coins < item.Price {
+		m.Notice = fmt.Sprintf("%s costs %d coins, you have %d", item.Name, item.Price, m.Wallet.Coins)
+		return m
+	}
+
+	// Pay from the balance on disk, which another session may have spent in the meantime
+	wallet, err := m.Store.Spend(item.ID, item.Price)
+	if errors.Is(err, storage.ErrNotEnoughCoins) {
+		m.Wallet = wallet
+		m.Notice = fmt.Sprintf("%s costs %d coins, you have %d", item.Name, item.Price, wallet.Coins)
+		return m
+	}
+	if err != nil {
+		m.Notice = fmt.Sprintf("Couldn't save your purchase: %v", err)
+		return m
+	}
+	m.Wallet = wallet
+
+	m = m.equip(item)
+	m.Notice = fmt.Sprintf("Unlocked %s!", item.Name)
+	return m
+}
+
+// equip puts an owned item to use and remembers it for the next game
+func (m Model) equip(item ShopItem) Model {
+	if item.Sprite != nil {
+		m.Sprite = *item.Sprite
+	} else {
+		m.Theme = item.Theme
+	}
+	if wallet, err := m.Store.Equip(m.Sprite.Name, m.Theme.String()); err == nil {
+		m.Wallet = wallet
+	}
+	return m
+}
+
+// nextTheme returns the next theme in rotation that is free or has been bought
+func (m Model) nextTheme() domain.Theme {
+	for t := m.Theme.Next(); t != m.Theme; t = t.Next() {
+		if m.Owns(themeItem(t)) {
+			return t
+		}
+	}
+	return m.Theme
+}
+
+// bankCoins adds the coins collected during the run to the balance
+func (m Model) bankCoins() Model {
+	if m.World.CoinsCollected == 0 {
+		return m
+	}
+	if wallet, err := m.Store.AddCoins(m.World.CoinsCollected); err == nil {
+		m.Wallet = wallet
+	}
+	return m
+}
@@ -0,0 +1,48 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+func TestSessionsOfOnePlayerBankCoins(t *testing.T) {
+	store, err := storage.ServerStore(t.TempDir()).ForPlayer("ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both sessions are open before either run ends
+	bank := func(m Model, coins int) Model {
+		m = m.resetGame()
+		m.World.CoinsCollected = coins
+		return m.bankCoins()
+	}
+	first := NewModel(Options{Seed: 1, Store: store, Quiet: true})
+	second := NewModel(Options{Seed: 1, Store: store, Quiet: true})
+	first = bank(first, 5)
+	second = bank(second, 3)
+
+	if second.Wallet.Coins != 8 {
+		t.Errorf("balance after banking 5 and 3 coins = %d, want 8", second.Wallet.Coins)
+	}
+	if w, err := store.LoadWallet(); err != nil || w.Coins != 8 {
+		t.Errorf("saved balance = %+v, %v, want 8", w, err)
+	}
+}
+
+func TestBuySpendsSavedBalance(t *testing.T) {
+	m := newTestModel(t)
+	if _, err := m.Store.AddCoins(30); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Wallet = &storage.Wallet{Coins: 30}
+	item := themeItem(domain.ThemeOcean)
+	m = m.buy(item)
+
+	if !m.Owns(item) || m.Theme != domain.ThemeOcean || m.Wallet.Coins != 30-item.Price {
+		t.Errorf("after buying %s: owns %v, theme %s, %d coins", item.Name, m.Owns(item), m.Theme, m.Wallet.Coins)
+	}
+}
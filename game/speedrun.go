@@ -0,0 +1,30 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/takish/flappy-bird-tui/domain"
+)
+
+// speedrunKey identifies the leaderboard and best splits of a speedrun target and difficulty
+func speedrunKey(target int, difficulty domain.Difficulty) string {
+	return fmt.Sprintf("%s %d %s", domain.ModeSpeedrun, target, difficulty)
+}
+
+// SplitDelta returns how far split i of the current run is behind the best splits
+// A negative delta means the run is ahead; ok is false when there is no best split to compare with
+func (m Model) SplitDelta(i int) (delta time.Duration, ok bool) {
+	if i >= len(m.World.Splits) || i >= len(m.PBSplits) {
+		return 0, false
+	}
+	return m.World.Splits[i] - m.PBSplits[i], true
+}
+
+// handleSpeedrunGameOver keeps the splits of a finished speedrun if it is the fastest yet
+func (m Model) handleSpeedrunGameOver() Model {
+	if splits, _, err := m.Store.AddBestSplits(m.LeaderboardName(), m.World.Splits); err == nil {
+		m.BestSplits = splits
+	}
+	return m
+}
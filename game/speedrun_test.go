@@ -0,0 +1,63 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/takish/flappy-bird-tui/domain"
+)
+
+func TestSplitDelta(t *testing.T) {
+	s := time.Second
+	tests := []struct {
+		name      string
+		run       []time.Duration
+		pb        []time.Duration
+		i         int
+		wantDelta time.Duration
+		wantOK    bool
+	}{
+		{name: "behind", run: []time.Duration{12 * s}, pb: []time.Duration{10 * s}, i: 0, wantDelta: 2 * s, wantOK: true},
+		{name: "ahead", run: []time.Duration{10 * s, 18 * s}, pb: []time.Duration{10 * s, 20 * s}, i: 1, wantDelta: -2 * s, wantOK: true},
+		{name: "no personal best", run: []time.Duration{10 * s}, pb: nil, i: 0, wantOK: false},
+		{name: "split not reached", run: []time.Duration{10 * s}, pb: []time.Duration{10 * s, 20 * s}, i: 1, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Model{World: &domain.World{Splits: tt.run}, PBSplits: tt.pb}
+			delta, ok := m.SplitDelta(tt.i)
+			if delta != tt.wantDelta || ok != tt.wantOK {
+				t.Errorf("SplitDelta(%d) = %v, %v, want %v, %v", tt.i, delta, ok, tt.wantDelta, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSpeedrunKeepsFastestSplits(t *testing.T) {
+	m := newTestModel(t)
+	m.Mode = domain.ModeSpeedrun
+	m = m.resetGame()
+	key := m.LeaderboardName()
+
+	finish := func(splits ...time.Duration) {
+		m.World.Splits = splits
+		m = m.handleSpeedrunGameOver()
+	}
+	finish(10*time.Second, 21*time.Second)
+	finish(9*time.Second, 22*time.Second) // Ahead early but slower overall
+
+	want := []time.Duration{10 * time.Second, 21 * time.Second}
+	if got := m.BestSplits[key]; len(got) != 2 || got[1] != want[1] {
+		t.Fatalf("best splits = %v, want %v", got, want)
+	}
+
+	// The next run is compared with the stored best, which is saved for later sessions
+	if next := m.resetGame(); len(next.PBSplits) != 2 || next.PBSplits[1] != want[1] {
+		t.Errorf("next run compares against %v, want %v", next.PBSplits, want)
+	}
+	saved, err := m.Store.LoadBestSplits()
+	if err != nil || len(saved[key]) != 2 {
+		t.Errorf("saved splits = %v, %v", saved[key], err)
+	}
+}
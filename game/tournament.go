@@ -0,0 +1,168 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// Tournament settings
+const (
+	MaxTournamentPlayers = 8  // Players that fit the round-robin table
+	maxNameLength        = 12 // Longest player name
+	defaultRounds        = 3  // Rounds offered when setting up a tournament
+	maxRounds            = 5  // Most rounds a tournament can have
+)
+
+// Tournament holds the hot-seat tournament being set up or played
+type Tournament struct {
+	storage.Tournament        // Players, settings and the runs so far, saved once finished
+	Entry              string // Name being typed on the setup screen
+}
+
+// Round returns the round being played, 1-based
+func (t *Tournament) Round() int {
+	return len(t.Runs)/len(t.Players) + 1
+}
+
+// NextPlayer returns the player whose turn is next
+func (t *Tournament) NextPlayer() string {
+	return t.Players[len(t.Runs)%len(t.Players)]
+}
+
+// Done reports whether every player has played every round
+func (t *Tournament) Done() bool {
+	return len(t.Runs) == t.Rounds*len(t.Players)
+}
+
+// openTournament shows the tournament setup from the title screen
+func (m Model) openTournament() Model {
+	m.State = StateTournamentSetup
+	m.Tournament = &Tournament{Tournament: storage.Tournament{Rounds: defaultRounds}}
+	m.Notice = ""
+	return m
+}
+
+// handleTournamentSetupKey handles typing player names and choosing the number of rounds
+func (m Model) handleTournamentSetupKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	t := m.Tournament
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEsc:
+		m.State = StateTitle
+		m.Tournament = nil
+		m.Notice = ""
+	case tea.KeyRunes, tea.KeySpace:
+		if name := t.Entry + string(msg.Runes); utf8.RuneCountInString(name) <= maxNameLength {
+			t.Entry = name
+		}
+	case tea.KeyBackspace: // Delete a letter, or the last player once the name is empty
+		if t.Entry != "" {
+			_, size := utf8.DecodeLastRuneInString(t.Entry)
+			t.Entry = t.Entry[:len(t.Entry)-size]
+		} else if len(t.Players) > 0 {
+			t.Players = t.Players[:len(t.Players)-1]
+		}
+	case tea.KeyLeft:
+		t.Rounds = max(t.Rounds-1, 1)
+	case tea.KeyRight:
+		t.Rounds = min(t.Rounds+1, maxRounds)
+	case tea.KeyEnter: // Add the typed name, or start once the name is empty
+		name := strings.TrimSpace(t.Entry)
+		if name != "" {
+			return m.addTournamentPlayer(name), nil
+		}
+		return m.startTournament(), nil
+	}
+	return m, nil
+}
+
+// addTournamentPlayer adds a player to the tournament being set up
+func (m Model) addTournamentPlayer(name string) Model {
+	t := m.Tournament
+	switch {
+	case len(t.Players) == MaxTournamentPlayers:
+		m.Notice = fmt.Sprintf("A tournament has at most %d players", MaxTournamentPlayers)
+	case slices.ContainsFunc(t.Players, func(p string) bool { return strings.EqualFold(p, name) }):
+		m.Notice = fmt.Sprintf("%s is already playing", name)
+	default:
+		t.Players = append(t.Players, name)
+		t.Entry = ""
+		m.Notice = ""
+	}
+	return m
+}
+
+// startTournament fixes the seed and settings every turn is played with and shows the table
+func (m Model) startTournament() Model {
+	t := m.Tournament
+	if len(t.Players) < 2 {
+		m.Notice = "Add at least two players"
+		return m
+	}
+	if m.Course != nil && m.Height < m.Course.Height {
+		m.Notice = fmt.Sprintf("This course needs a terminal at least %d rows high", m.Course.Height)
+		return m
+	}
+
+	seed := m.FixedSeed
+	if seed == 0 {
+		seed = domain.NewSeed()
+	}
+	m.Mode = domain.ModeClassic
+	t.Date = time.Now()
+	t.Seed = seed
+	t.Difficulty = m.Difficulty.String()
+	t.Hitbox = m.Hitbox.String()
+	t.PowerUps = m.PowerUps
+	m.State = StateTournament
+	m.Notice = ""
+	return m
+}
+
+// handleTournamentKey handles key presses on the tournament table between turns
+func (m Model) handleTournamentKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case " ", "enter": // Next player's turn, or back to the title once it's over
+		if m.Tournament.Done() {
+			m.State = StateTitle
+			m.Tournament = nil
+			return m, nil
+		}
+		m = m.resetGame()
+		return m, tick(frameInterval)
+	case "esc": // Abandon the tournament
+		m.State = StateTitle
+		m.Tournament = nil
+	}
+	return m, nil
+}
+
+// handleTournamentTurn records the run that just ended and saves the tournament once everyone has played
+func (m Model) handleTournamentTurn() Model {
+	t := m.Tournament
+	t.Runs = append(t.Runs, storage.TournamentRun{
+		Player:   t.NextPlayer(),
+		Round:    t.Round(),
+		Score:    m.World.Score,
+		Duration: m.World.Elapsed(),
+		Cleared:  m.World.Cleared,
+	})
+	m.State = StateTournament
+
+	if t.Done() {
+		if tournaments, err := m.Store.AddTournament(t.Tournament); err == nil {
+			m.Tournaments = tournaments
+		}
+	}
+	return m
+}
@@ -1,6 +1,7 @@
 package game
 
 import (
+	"fmt"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -8,15 +9,24 @@ import (
 	"github.com/takish/flappy-bird-tui/storage"
 )
 
-// TickMsg is sent on every game tick
+// TickMsg is sent on every frame tick
 type TickMsg time.Time
 
 const (
-	pipeSpawnGap = 50 // Horizontal gap between pipes
+	frameInterval    = 16 * time.Millisecond // ~60 FPS rendering, independent of the physics step
+	maxStepsPerFrame = 10                    // Drop time beyond this after a stall instead of fast-forwarding
 )
 
 // Init initializes the game
 func (m Model) Init() tea.Cmd {
+	// Replays and network matches start playing right away
+	if m.Playback != nil || m.Net != nil {
+		return tick(frameInterval)
+	}
+	// The editor checks the loaded course straight away
+	if m.State == StateEditor {
+		return m.checkReachable()
+	}
 	return nil
 }
 
@@ -24,8 +34,40 @@ func (m Model) Init() tea.Cmd {
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.Playback != nil {
+			return m.handlePlaybackKey(msg)
+		}
+		if m.Net != nil {
+			return m.handleNetKey(msg)
+		}
+		if m.State == StateDailyBoard {
+			return m.handleDailyBoardKey(msg)
+		}
+		if m.State == StateEditor {
+			return m.handleEditorKey(msg)
+		}
+		if m.State == StateShop {
+			return m.handleShopKey(msg)
+		}
+		if m.State == StateTournamentSetup {
+			return m.handleTournamentSetupKey(msg)
+		}
+		if m.State == StateTournament {
+			return m.handleTournamentKey(msg)
+		}
+		// Each player's own key comes first during a versus match
+		if p := m.playerWithKey(msg.String()); p != nil && m.State == StatePlaying {
+			p.JumpQueued = true
+			return m, nil
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c":
+			// A play-test goes back to the editor, which asks before unsaved changes are lost
+			if m.Editor != nil && m.Editor.Modified && msg.String() == "q" {
+				m.State = StateEditor
+				return m.handleEditorKey(msg)
+			}
 			return m, tea.Quit
 
 		case "1", "2", "3": // Difficulty selection (title screen only)
@@ -40,141 +82,294 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				}
 			}
 
+		case "h": // Hitbox mode toggle (title screen only)
+			if m.State == StateTitle {
+				m.Hitbox = m.Hitbox.Next()
+			}
+
+		case "m": // Mode toggle (title screen only)
+			if m.State == StateTitle {
+				m.Mode = m.Mode.Next()
+			}
+
+		case "n": // Speedrun target toggle (title screen only)
+			if m.State == StateTitle && m.Mode == domain.ModeSpeedrun {
+				m.Target = domain.NextSpeedrunTarget(m.Target)
+			}
+
+		case "u": // Power-ups toggle (title screen only)
+			if m.State == StateTitle {
+				m.PowerUps = !m.PowerUps
+			}
+
+		case "b": // Rewind (practice mode only)
+			if m.State == StatePractice {
+				m = m.rewind()
+			}
+
+		case "i": // Invincibility toggle (practice mode only)
+			if m.State == StatePractice {
+				m = m.toggleInvincible()
+			}
+
+		case "d": // Daily challenge (title screen only)
+			if m.State == StateTitle {
+				return m.startDaily()
+			}
+
+		case "s": // Shop (title screen only)
+			if m.State == StateTitle {
+				m = m.openShop()
+			}
+
+		case "o": // Tournament (title screen only)
+			if m.State == StateTitle {
+				m = m.openTournament()
+			}
+
+		case "l": // Daily results (title screen only)
+			if m.State == StateTitle {
+				m = m.showDailyBoard()
+			}
+
+		case "esc": // Back to the title screen after a run or from practice
+			if m.Editor != nil {
+				// Play-tests go back to the editor
+				m.State = StateEditor
+			} else if m.State == StatePlaying && m.Mode == domain.ModeZen {
+				// Zen runs can be ended at any time to see how they went
+				m.State = StateGameOver
+				m = m.handleGameOver()
+			} else if m.State == StateGameOver || m.State == StatePractice {
+				m.State = StateTitle
+				m.DailyDate = ""
+			}
+
 		case "t": // Theme toggle (title screen only)
 			if m.State == StateTitle {
-				m.Theme = m.Theme.Next()
+				m = m.equip(themeItem(m.nextTheme()))
 			}
 
 		case " ": // Space bar
 			switch m.State {
 			case StateTitle:
+				if m.Course != nil && m.Height < m.Course.Height {
+					m.Notice = fmt.Sprintf("This course needs a terminal at least %d rows high", m.Course.Height)
+					return m, nil
+				}
+				if m.Mode == domain.ModeVersus && VersusWidth(m.Width) < MinVersusWidth {
+					m.Notice = fmt.Sprintf("Versus needs a terminal at least %d columns wide", MinVersusWidth*versusPlayers+versusPlayers-1)
+					return m, nil
+				}
+				m.Notice = ""
 				m = m.resetGame()
-				return m, tick(m.GameSpeed)
-			case StatePlaying:
-				m.Bird.Jump()
-				m.Stats.JumpCount++ // Track jump count
-				storage.PlaySound("jump")
+				return m, tick(frameInterval)
+			case StatePlaying, StatePractice:
+				m.Held = false      // Resume after a rewind
+				m.JumpQueued = true // Applied on the next tick
 			case StateGameOver:
 				m = m.resetGame()
-				return m, tick(m.GameSpeed)
+				return m, tick(frameInterval)
 			}
 
 		case "r":
 			if m.State == StateGameOver {
 				m = m.resetGame()
-				return m, tick(m.GameSpeed)
+				return m, tick(frameInterval)
 			}
 		}
 
 	case TickMsg:
-		if m.State != StatePlaying {
+		if !m.Running() {
 			return m, nil
 		}
-
-		// Update bird physics
-		m.Bird.Update()
-
-		// Track height statistics
-		birdY := m.Bird.GetY()
-		if birdY < m.Stats.MaxHeight {
-			m.Stats.MaxHeight = birdY
-		}
-		if birdY > m.Stats.MinHeight {
-			m.Stats.MinHeight = birdY
+		if m.Playback != nil {
+			return m.updatePlayback(time.Time(msg))
 		}
-		m.Stats.TotalHeight += birdY
-		m.Stats.HeightSamples++
 
-		// Check ceiling/floor collision
-		if m.Bird.GetY() < 0 || m.Bird.GetY() >= m.Height {
-			m.State = StateGameOver
-			m = m.handleGameOver()
+		m = m.advance(time.Time(msg), 1)
+		if !m.Running() {
 			return m, nil
 		}
+		return m, tick(frameInterval)
 
-		// Update pipes
-		for i := len(m.Pipes) - 1; i >= 0; i-- {
-			pipe := m.Pipes[i]
-			pipe.Update()
+	case reachCheckMsg, reachResultMsg:
+		return m.handleReachMsg(msg)
 
-			// Check collision
-			if pipe.CollidesWith(m.Bird) {
-				m.State = StateGameOver
-				m = m.handleGameOver()
-				return m, nil
-			}
-
-			// Check if passed
-			if pipe.IsPassed(m.Bird) {
-				pipe.Passed = true
-				m.Score++
-				storage.PlaySound("score")
-
-				// Increase difficulty based on settings
-				settings := m.Difficulty.GetSettings()
-				if m.Score%settings.ScoreInterval == 0 {
-					// Speed up game (reduce interval)
-					if m.GameSpeed > settings.MinSpeed {
-						m.GameSpeed -= settings.SpeedIncrement
-					}
-				}
-			}
+	case playTestMsg:
+		return m.handlePlayTestMsg(msg)
+
+	case tea.WindowSizeMsg:
+		// The running world keeps its size, only rendering follows the terminal
+		m.Width = msg.Width
+		m.Height = msg.Height
+	}
+
+	return m, nil
+}
+
+// advance runs as many fixed physics steps as the real time since the last frame covers
+// rate scales real time, e.g. for replay playback speed
+func (m Model) advance(now time.Time, rate float64) Model {
+	m.Lag += time.Duration(float64(now.Sub(m.LastFrame)) * rate)
+	m.LastFrame = now
+	if m.Held {
+		m.Lag = 0
+		return m
+	}
 
-			// Remove off-screen pipes
-			if pipe.IsOffScreen() {
-				m.Pipes = append(m.Pipes[:i], m.Pipes[i+1:]...)
+	for steps := 0; m.Lag >= domain.StepDuration && m.Running() && !m.Held; steps++ {
+		if steps == maxStepsPerFrame {
+			m.Lag = 0
+			break
+		}
+		m.Lag -= domain.StepDuration
+
+		if m.Playback != nil {
+			m = m.playbackStep()
+		} else if m.Net != nil {
+			var stepped bool
+			if m, stepped = m.stepNet(); !stepped {
+				m.Lag += domain.StepDuration // Owed until the opponent catches up
+				break
 			}
+		} else if m.Players != nil {
+			m = m.stepVersus()
+		} else {
+			m = m.step(domain.Input{Jump: m.JumpQueued})
+			m.JumpQueued = false
 		}
+	}
+
+	return m
+}
+
+// playSound plays a sound unless sounds are muted
+func (m Model) playSound(soundType string) {
+	if !m.Quiet {
+		storage.PlaySound(soundType)
+	}
+}
+
+// step advances the world by one physics step with the given input
+func (m Model) step(in domain.Input) Model {
+	if in.Jump {
+		m.Recording.Jumps = append(m.Recording.Jumps, m.World.Tick)
+	}
+	ev := m.World.Step(in)
+	if m.Ghost != nil {
+		m.Ghost.step()
+	}
 
-		// Spawn new pipes
-		if len(m.Pipes) == 0 || m.Pipes[len(m.Pipes)-1].X < m.Width-pipeSpawnGap {
-			settings := m.Difficulty.GetSettings()
-			m.Pipes = append(m.Pipes, domain.NewPipe(m.Width, m.Height, settings.PipeGap))
+	if ev.Jumped {
+		m.Stats.JumpCount++ // Track jump count
+		m.playSound("jump")
+	}
+	if ev.Scored || ev.PoweredUp {
+		m.playSound("score")
+	}
+	if ev.Respawned {
+		m.playSound("gameover")
+		// Wait for the player before carrying on from the checkpoint, replays carry on by themselves
+		if m.Playback == nil {
+			m.Held = true
+			m.JumpQueued = false
 		}
+	}
 
-		return m, tick(m.GameSpeed)
+	// Track height statistics
+	birdY := m.World.Bird.GetY()
+	if birdY < m.Stats.MaxHeight {
+		m.Stats.MaxHeight = birdY
+	}
+	if birdY > m.Stats.MinHeight {
+		m.Stats.MinHeight = birdY
+	}
+	m.Stats.TotalHeight += birdY
+	m.Stats.HeightSamples++
 
-	case tea.WindowSizeMsg:
-		m.Width = msg.Width
-		m.Height = msg.Height
+	if ev.Died || ev.Finished {
+		m.State = StateGameOver
+		m = m.handleGameOver()
 	}
 
-	return m, nil
+	return m.recordSnapshot()
 }
 
 // handleGameOver processes game over logic including high score checking
 func (m Model) handleGameOver() Model {
-	storage.PlaySound("gameover")
-	elapsed := time.Since(m.StartTime)
+	m.playSound("gameover")
+
+	// Tournament turns go to the tournament table, not the rankings or the wallet
+	if m.Tournament != nil {
+		return m.handleTournamentTurn()
+	}
+
+	// Coins from every played run are kept, ranked or not
+	if m.Playback == nil && m.Editor == nil {
+		m = m.bankCoins()
+	}
+
+	// Replays are only watched, never scored again, and unranked modes and courses aren't saved
+	if m.Playback != nil || !m.Mode.Ranked() || m.World.Course != nil {
+		return m
+	}
+
+	now := time.Now()
+
+	// Save the run's inputs so it can be shared and played back
+	m.Recording.Ticks = m.World.Tick
+	m.Recording.Score = m.World.Score
+	m.Recording.Date = now
+	replayName, _ := m.Store.SaveReplay(m.Recording)
 
 	// Create high score entry with statistics
 	newScore := storage.HighScore{
-		Score:      m.Score,
-		Duration:   elapsed,
-		Date:       time.Now(),
+		Player:     m.Store.Player,
+		Score:      m.World.Score,
+		Duration:   m.World.Elapsed(),
+		Date:       now,
 		JumpCount:  m.Stats.JumpCount,
 		MaxHeight:  m.Stats.MaxHeight,
 		MinHeight:  m.Stats.MinHeight,
 		AvgHeight:  m.AvgHeight(),
-		Difficulty: m.Difficulty.String(),
+		Difficulty: m.World.Difficulty.String(),
+		Mode:       m.World.Mode.String(),
+		Hitbox:     m.World.Hitbox.String(),
+		Seed:       m.World.Seed,
+		PowerUps:   m.World.PowerUps,
+		Replay:     replayName,
+		Digest:     m.Recording.Digest(),
 	}
 
-	// Check if this is a new high score
-	if storage.IsNewHighScore(m.Score, m.HighScore) {
-		m.IsNewRecord = true
-		// Save new high score
-		if err := storage.SaveHighScore(newScore); err == nil {
-			// Reload high score from disk
-			if hs, err := storage.LoadHighScore(); err == nil {
-				m.HighScore = hs
-			}
+	// Daily runs go to their own board, not the rankings
+	if m.DailyDate != "" {
+		return m.handleDailyGameOver(newScore)
+	}
+
+	// Other modes and power-up runs are ranked on their own leaderboard
+	if m.World.Mode != domain.ModeClassic || m.World.PowerUps {
+		if boards, rank, err := m.Store.AddModeRanking(m.LeaderboardName(), newScore); err == nil {
+			m.ModeRankings = boards
+			m.IsNewRecord = rank == 1
+		}
+		if m.World.Mode == domain.ModeSpeedrun {
+			m = m.handleSpeedrunGameOver()
+		}
+		return m
+	}
+
+	// Check if this is a new high score, against the one on disk in case another session beat it
+	if storage.IsNewHighScore(m.World.Score, m.HighScore) {
+		if hs, isNew, err := m.Store.AddHighScore(newScore); err == nil {
+			m.HighScore = hs
+			m.IsNewRecord = isNew
 		}
 	}
 
-	// Add to rankings
-	newRankings, _ := storage.AddToRankings(m.Rankings, newScore)
-	if err := storage.SaveRankings(newRankings); err == nil {
-		m.Rankings = newRankings
+	// Add to rankings, along with any scores other players saved since they were loaded
+	if rankings, _, err := m.Store.AddRanking(newScore); err == nil {
+		m.Rankings = rankings
 	}
 
 	return m
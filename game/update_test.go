@@ -0,0 +1,66 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// newTestModel returns a game that saves into a temporary directory and never rings the bell
+func newTestModel(t *testing.T) Model {
+	t.Helper()
+	return NewModel(Options{Seed: 1, Store: &storage.Store{Dir: t.TempDir(), Player: "tester"}, Quiet: true})
+}
+
+func TestAdvanceFixedTimestep(t *testing.T) {
+	tests := []struct {
+		name     string
+		frames   []time.Duration
+		rate     float64
+		wantTick int
+		wantLag  time.Duration
+	}{
+		{name: "one step", frames: []time.Duration{15 * time.Millisecond}, rate: 1, wantTick: 1},
+		{name: "less than a step", frames: []time.Duration{10 * time.Millisecond}, rate: 1, wantTick: 0, wantLag: 10 * time.Millisecond},
+		{name: "lag carries over", frames: []time.Duration{10 * time.Millisecond, 10 * time.Millisecond}, rate: 1, wantTick: 1, wantLag: 5 * time.Millisecond},
+		{name: "several steps per frame", frames: []time.Duration{45 * time.Millisecond}, rate: 1, wantTick: 3},
+		{name: "60 fps frames", frames: repeat(frameInterval, 15), rate: 1, wantTick: 16},
+		{name: "stall is dropped", frames: []time.Duration{time.Second}, rate: 1, wantTick: maxStepsPerFrame},
+		{name: "double speed", frames: []time.Duration{30 * time.Millisecond}, rate: 2, wantTick: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t).resetGame()
+			now := m.LastFrame
+			for _, d := range tt.frames {
+				now = now.Add(d)
+				m = m.advance(now, tt.rate)
+			}
+
+			if m.World.Tick != tt.wantTick || m.Lag != tt.wantLag {
+				t.Errorf("tick %d with %v lag, want tick %d with %v lag", m.World.Tick, m.Lag, tt.wantTick, tt.wantLag)
+			}
+		})
+	}
+}
+
+func TestAdvanceHeldDropsLag(t *testing.T) {
+	m := newTestModel(t).resetGame()
+	m.Held = true
+
+	m = m.advance(m.LastFrame.Add(100*time.Millisecond), 1)
+	if m.World.Tick != 0 || m.Lag != 0 {
+		t.Errorf("held world advanced to tick %d with %v lag", m.World.Tick, m.Lag)
+	}
+}
+
+// repeat returns n copies of d
+func repeat(d time.Duration, n int) []time.Duration {
+	frames := make([]time.Duration, n)
+	for i := range frames {
+		frames[i] = d
+	}
+	return frames
+}
@@ -0,0 +1,75 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// ErrOtherVersion is returned for replays recorded by another build, whose simulation may differ
+var ErrOtherVersion = errors.New("recorded by another version")
+
+// VerifyScore re-simulates a score's replay and checks that it produces the claimed result
+// board is the name of the leaderboard the score is on, as given by LeaderboardName,
+// so a run from an easier mode can't be passed off on a harder mode's board
+// Replays recorded by a version other than the given one can't be checked and return ErrOtherVersion
+func VerifyScore(store *storage.Store, board string, hs storage.HighScore, version string) error {
+	if hs.Replay == "" || hs.Digest == "" {
+		return fmt.Errorf("no replay attached")
+	}
+
+	path, err := store.ReplayPath(hs.Replay)
+	if err != nil {
+		return err
+	}
+	r, err := storage.LoadReplay(path)
+	if err != nil {
+		return fmt.Errorf("load replay: %w", err)
+	}
+	if r.Digest() != hs.Digest {
+		return fmt.Errorf("replay digest mismatch")
+	}
+	mode := domain.ParseMode(r.Mode)
+	if !mode.Ranked() {
+		return fmt.Errorf("%s runs are never ranked", mode)
+	}
+	if name := leaderboardName(mode, r.Target, domain.ParseDifficulty(r.Difficulty), r.PowerUps); name != board {
+		return fmt.Errorf("run belongs on the %s leaderboard, not %s", name, board)
+	}
+	if r.Version != version {
+		return fmt.Errorf("%w (%s)", ErrOtherVersion, r.Version)
+	}
+
+	// The score must describe the same run as the replay
+	if r.Seed != hs.Seed || r.Mode != hs.Mode || r.Difficulty != hs.Difficulty || r.Hitbox != hs.Hitbox ||
+		r.PowerUps != hs.PowerUps {
+		return fmt.Errorf("replay settings don't match the score")
+	}
+
+	// Re-simulate the recorded inputs until the bird dies
+	world := domain.NewWorld(replayConfig(r))
+	tape := domain.NewInputTape(r.Jumps)
+	jumps := 0
+	for !world.Over && world.Tick < r.Ticks {
+		if world.Step(tape.Next(world.Tick)).Jumped {
+			jumps++
+		}
+	}
+
+	if !world.Over || world.Tick != r.Ticks {
+		return fmt.Errorf("run doesn't end at step %d", r.Ticks)
+	}
+	if world.Score != hs.Score {
+		return fmt.Errorf("score %d, re-simulation gives %d", hs.Score, world.Score)
+	}
+	if world.Elapsed() != hs.Duration {
+		return fmt.Errorf("duration %v, re-simulation gives %v", hs.Duration, world.Elapsed())
+	}
+	if jumps != hs.JumpCount {
+		return fmt.Errorf("jump count %d, re-simulation gives %d", hs.JumpCount, jumps)
+	}
+
+	return nil
+}
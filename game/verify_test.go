@@ -0,0 +1,107 @@
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+// playRankedRun plays a classic run to the end and returns the game with its score ranked
+func playRankedRun(t *testing.T) Model {
+	t.Helper()
+	m := playRun(t, domain.ModeClassic, false)
+	if len(m.Rankings) != 1 {
+		t.Fatalf("rankings hold %d scores after one run, want 1", len(m.Rankings))
+	}
+	return m
+}
+
+// playRun plays a run in a mode to the end and returns the game
+func playRun(t *testing.T, mode domain.Mode, powerUps bool) Model {
+	t.Helper()
+	m := newTestModel(t)
+	m.Version = "test"
+	m.Mode = mode
+	m.PowerUps = powerUps
+	m = m.resetGame()
+	for m.State == StatePlaying {
+		m = m.step(domain.Input{Jump: m.World.Tick%24 == 0})
+	}
+	return m
+}
+
+func TestVerifyScoreRoundTrip(t *testing.T) {
+	m := playRankedRun(t)
+	if err := VerifyScore(m.Store, "Classic", m.Rankings[0], "test"); err != nil {
+		t.Errorf("honest score failed verification: %v", err)
+	}
+}
+
+func TestVerifyScoreChecksBoard(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     domain.Mode
+		powerUps bool
+		board    string
+		wantOK   bool
+	}{
+		{name: "classic on its board", mode: domain.ModeClassic, board: "Classic", wantOK: true},
+		{name: "classic on a mode board", mode: domain.ModeClassic, board: "Time Attack"},
+		{name: "time attack on its board", mode: domain.ModeTimeAttack, board: "Time Attack", wantOK: true},
+		{name: "time attack on the classic board", mode: domain.ModeTimeAttack, board: "Classic"},
+		{name: "power-ups on their board", mode: domain.ModeClassic, powerUps: true, board: "Classic Power-ups", wantOK: true},
+		{name: "power-ups on the classic board", mode: domain.ModeClassic, powerUps: true, board: "Classic"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := playRun(t, tt.mode, tt.powerUps)
+			board := m.Leaderboard()
+			if len(board) != 1 {
+				t.Fatalf("%s holds %d scores after one run, want 1", m.LeaderboardName(), len(board))
+			}
+
+			err := VerifyScore(m.Store, tt.board, board[0], "test")
+			if (err == nil) != tt.wantOK {
+				t.Errorf("err = %v, want ok = %v", err, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestVerifyScoreRejectsTampering(t *testing.T) {
+	m := playRankedRun(t)
+	honest := m.Rankings[0]
+
+	tests := []struct {
+		name   string
+		tamper func(hs *storage.HighScore)
+	}{
+		{name: "score", tamper: func(hs *storage.HighScore) { hs.Score += 5 }},
+		{name: "duration", tamper: func(hs *storage.HighScore) { hs.Duration -= domain.StepDuration }},
+		{name: "jump count", tamper: func(hs *storage.HighScore) { hs.JumpCount++ }},
+		{name: "settings", tamper: func(hs *storage.HighScore) { hs.Difficulty = "Hard" }},
+		{name: "digest", tamper: func(hs *storage.HighScore) { hs.Digest = "tampered" }},
+		{name: "replay name", tamper: func(hs *storage.HighScore) { hs.Replay = "../" + hs.Replay }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hs := honest
+			tt.tamper(&hs)
+			err := VerifyScore(m.Store, "Classic", hs, "test")
+			if err == nil || errors.Is(err, ErrOtherVersion) {
+				t.Errorf("tampered %s: err = %v, want a failure", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestVerifyScoreOtherVersion(t *testing.T) {
+	m := playRankedRun(t)
+	if err := VerifyScore(m.Store, "Classic", m.Rankings[0], "v9.9.9"); !errors.Is(err, ErrOtherVersion) {
+		t.Errorf("err = %v, want ErrOtherVersion", err)
+	}
+}
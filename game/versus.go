@@ -0,0 +1,121 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/takish/flappy-bird-tui/domain"
+)
+
+// Versus settings
+const (
+	DefaultP2Key   = "up" // Key player two jumps with unless --p2-key picks another
+	versusPlayers  = 2    // Players sharing the screen
+	MinVersusWidth = 30   // Narrowest playfield a player gets
+)
+
+// Player is one of the players sharing the screen in versus mode
+type Player struct {
+	Name       string
+	Key        string        // Key that makes this player's bird jump
+	World      *domain.World // Player's own run, on the same seed as everyone else's
+	JumpQueued bool          // Jump pressed since the last tick
+}
+
+// KeyName returns a key as shown on screen
+func KeyName(key string) string {
+	if key == " " {
+		return "Space"
+	}
+	return key
+}
+
+// VersusWidth returns the width of each player's playfield, side by side with a separator in between
+func VersusWidth(termWidth int) int {
+	return (termWidth - (versusPlayers - 1)) / versusPlayers
+}
+
+// newPlayers creates a world for every player from the same config, so everyone flies through identical pipes
+func (m Model) newPlayers(cfg domain.Config) []*Player {
+	keys := []string{" ", m.P2Key}
+	players := make([]*Player, len(keys))
+	for i, key := range keys {
+		players[i] = &Player{
+			Name:  fmt.Sprintf("P%d", i+1),
+			Key:   key,
+			World: domain.NewWorld(cfg),
+		}
+	}
+	return players
+}
+
+// playerWithKey returns the player who jumps with the key, nil if there is none
+func (m Model) playerWithKey(key string) *Player {
+	for _, p := range m.Players {
+		if p.Key == key {
+			return p
+		}
+	}
+	return nil
+}
+
+// stepVersus advances every player still in the air by one physics step
+// The match ends once every bird is down or across the finish line
+func (m Model) stepVersus() Model {
+	over := true
+	for _, p := range m.Players {
+		if p.World.Over {
+			continue
+		}
+		m.playSounds(p.World.Step(domain.Input{Jump: p.JumpQueued}))
+		p.JumpQueued = false
+		over = over && p.World.Over
+	}
+
+	if over {
+		m.State = StateGameOver
+	}
+	return m
+}
+
+// playSounds plays the sounds of what happened to a player's bird in a step
+func (m Model) playSounds(ev domain.Events) {
+	if ev.Jumped {
+		m.playSound("jump")
+	}
+	if ev.Scored || ev.PoweredUp {
+		m.playSound("score")
+	}
+	if ev.Died {
+		m.playSound("gameover")
+	}
+}
+
+// Winner returns the player who did best in a finished versus match, nil on a draw
+func (m Model) Winner() *Player {
+	var best *Player
+	draw := false
+	for _, p := range m.Players {
+		switch {
+		case best == nil || beats(p.World, best.World):
+			best, draw = p, false
+		case !beats(best.World, p.World):
+			draw = true
+		}
+	}
+	if draw {
+		return nil
+	}
+	return best
+}
+
+// beats reports whether run a did better than run b
+// Clearing a course beats crashing and the faster clear wins, otherwise whoever stayed in the air longer wins
+func beats(a, b *domain.World) bool {
+	if a.Cleared != b.Cleared {
+		return a.Cleared
+	}
+	if a.Cleared {
+		return a.Tick < b.Tick
+	}
+	return a.Tick > b.Tick
+}
@@ -1,14 +1,38 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"maps"
+	"net"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	"github.com/charmbracelet/wish/activeterm"
+	bm "github.com/charmbracelet/wish/bubbletea"
+	"github.com/charmbracelet/wish/logging"
+	"github.com/takish/flappy-bird-tui/domain"
 	"github.com/takish/flappy-bird-tui/game"
+	"github.com/takish/flappy-bird-tui/netplay"
+	"github.com/takish/flappy-bird-tui/storage"
 	"github.com/takish/flappy-bird-tui/ui"
 )
 
+// SSH server settings
+const (
+	defaultSSHPort  = "2222"           // Port serve-ssh listens on when no address is given
+	shutdownTimeout = 10 * time.Second // Longest wait for sessions to end on shutdown
+)
+
 var (
 	version = "dev"
 	commit  = "none"
@@ -43,9 +67,279 @@ func main() {
 		return
 	}
 
-	p := tea.NewProgram(modelWrapper{game.NewModel()}, tea.WithAltScreen())
+	seed := flag.Uint64("seed", 0, "pipe seed to repeat a run exactly (0 picks a random seed)")
+	coursePath := flag.String("course", "", "play a handcrafted course file instead of random pipes")
+	p2Key := flag.String("p2-key", game.DefaultP2Key, "key player two jumps with in versus mode, e.g. up, enter or w")
+	difficulty := flag.String("difficulty", domain.DifficultyNormal.String(), "difficulty to start on and to host network matches with: Easy, Normal or Hard")
+	hitbox := flag.String("hitbox", domain.HitboxPrecise.String(), "hitbox mode to start with and to host network matches with: Precise, Forgiving or Classic")
+	powerUps := flag.Bool("power-ups", false, "start with power-ups on and host network matches with them")
+	flag.Parse()
+
+	// Player one always jumps with space
+	if *p2Key == "" || *p2Key == " " || *p2Key == "space" {
+		fmt.Println("Error: --p2-key must be a key other than space")
+		os.Exit(1)
+	}
+
+	// The names fall back to a default when parsed, so check they round-trip
+	if domain.ParseDifficulty(*difficulty).String() != *difficulty {
+		fmt.Println("Error: --difficulty must be Easy, Normal or Hard")
+		os.Exit(1)
+	}
+	if domain.ParseHitboxMode(*hitbox).String() != *hitbox {
+		fmt.Println("Error: --hitbox must be Precise, Forgiving or Classic")
+		os.Exit(1)
+	}
+
+	opts := game.Options{
+		Seed:       *seed,
+		Version:    version,
+		P2Key:      *p2Key,
+		Difficulty: *difficulty,
+		Hitbox:     *hitbox,
+		PowerUps:   *powerUps,
+	}
+	if *coursePath != "" {
+		course, err := game.LoadCourse(*coursePath)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		opts.Course = course
+	}
+	model := game.NewModel(opts)
+
+	args := flag.Args()
+	if len(args) > 0 {
+		switch args[0] {
+		case "replay":
+			if len(args) < 2 {
+				fmt.Println("Usage: flappy-bird-tui replay <file>")
+				os.Exit(1)
+			}
+			replay, err := storage.LoadReplay(args[1])
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				os.Exit(1)
+			}
+			model = game.NewReplayModel(replay, opts)
+
+		case "edit":
+			if len(args) < 2 {
+				fmt.Println("Usage: flappy-bird-tui edit <course file>")
+				os.Exit(1)
+			}
+			var err error
+			model, err = game.NewEditorModel(args[1], opts)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				os.Exit(1)
+			}
+
+		case "host":
+			addr := ":" + netplay.DefaultPort
+			if len(args) > 1 {
+				addr = args[1]
+			}
+			peer, err := host(addr, opts)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				os.Exit(1)
+			}
+			defer peer.Close()
+			model = game.NewNetModel(peer, opts)
+
+		case "join":
+			if len(args) < 2 {
+				fmt.Println("Usage: flappy-bird-tui join <host address>")
+				os.Exit(1)
+			}
+			fmt.Printf("Connecting to %s...\n", args[1])
+			peer, err := netplay.Join(args[1], storage.PlayerName(), version)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				os.Exit(1)
+			}
+			defer peer.Close()
+			model = game.NewNetModel(peer, opts)
+
+		case "verify":
+			os.Exit(verify(args[1:]))
+
+		case "serve-ssh":
+			os.Exit(serveSSH(args[1:], opts))
+
+		default:
+			fmt.Printf("Unknown command: %s\n", args[0])
+			os.Exit(1)
+		}
+	}
+
+	p := tea.NewProgram(modelWrapper{model}, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
 }
+
+// host waits for an opponent to join a network match on addr
+func host(addr string, opts game.Options) (*netplay.Peer, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer ln.Close()
+
+	fmt.Printf("Waiting for an opponent on %s...\n", ln.Addr())
+	return netplay.Host(ln, storage.PlayerName(), version, game.NetSetup(opts))
+}
+
+// verify re-simulates every ranked score and reports the ones that don't hold up
+// With -prune, failing entries are removed from the rankings
+// Scores recorded by another version can't be re-simulated, so they fail unless -keep-other-versions is given
+func verify(args []string) int {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	prune := fs.Bool("prune", false, "remove entries that fail verification from the rankings")
+	keepOther := fs.Bool("keep-other-versions", false, "skip and keep entries recorded by another version instead of failing them")
+	_ = fs.Parse(args)
+
+	store := storage.Home()
+	rankings, err := store.LoadRankings()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+	modeRankings, err := store.LoadModeRankings()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+
+	fmt.Println("Classic")
+	verified, failed := verifyBoard(store, domain.ModeClassic.String(), rankings, *keepOther)
+	verifiedModes := map[string][]storage.HighScore{}
+	for _, mode := range slices.Sorted(maps.Keys(modeRankings)) {
+		fmt.Println(mode)
+		board, modeFailed := verifyBoard(store, mode, modeRankings[mode], *keepOther)
+		verifiedModes[mode] = board
+		failed += modeFailed
+	}
+
+	if *prune && failed > 0 {
+		if err := store.SaveRankings(verified); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return 1
+		}
+		if err := store.SaveModeRankings(verifiedModes); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return 1
+		}
+		fmt.Printf("Removed %d unverified entries\n", failed)
+		return 0
+	}
+
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// verifyBoard verifies the scores of the named leaderboard and returns the ones that hold up
+// Scores recorded by another version fail, unless keepOther keeps them and reports them as skipped
+func verifyBoard(store *storage.Store, name string, board []storage.HighScore, keepOther bool) ([]storage.HighScore, int) {
+	verified := []storage.HighScore{}
+	failed := 0
+	for i, hs := range board {
+		err := game.VerifyScore(store, name, hs, version)
+		switch {
+		case keepOther && errors.Is(err, game.ErrOtherVersion):
+			fmt.Printf("%2d. %d pts  [%s]  SKIP: %v\n", i+1, hs.Score, hs.Difficulty, err)
+		case err != nil:
+			fmt.Printf("%2d. %d pts  [%s]  FAIL: %v\n", i+1, hs.Score, hs.Difficulty, err)
+			failed++
+			continue
+		default:
+			fmt.Printf("%2d. %d pts  [%s]  OK\n", i+1, hs.Score, hs.Difficulty)
+		}
+		verified = append(verified, hs)
+	}
+	return verified, failed
+}
+
+// serveSSH hosts the game over SSH until interrupted
+// Every session plays its own game on one store shared by all players, recording results under the SSH user name
+func serveSSH(args []string, opts game.Options) int {
+	fs := flag.NewFlagSet("serve-ssh", flag.ExitOnError)
+	dataDir := fs.String("data", "", "directory holding every player's scores and progress (default ~/.flappy-bird-tui/server)")
+	hostKey := fs.String("host-key", "", "server's private host key, created if missing (default <data>/host_key)")
+	_ = fs.Parse(args)
+
+	addr := ":" + defaultSSHPort
+	if fs.NArg() > 0 {
+		addr = fs.Arg(0)
+	}
+	if *dataDir == "" {
+		home := storage.Home()
+		if home.Dir == "" {
+			fmt.Println("Error: no home directory, pick a data directory with --data")
+			return 1
+		}
+		*dataDir = filepath.Join(home.Dir, "server")
+	}
+	if *hostKey == "" {
+		*hostKey = filepath.Join(*dataDir, "host_key")
+	}
+	if err := os.MkdirAll(*dataDir, 0755); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+
+	store := storage.ServerStore(*dataDir)
+	server, err := wish.NewServer(
+		wish.WithAddress(addr),
+		wish.WithHostKeyPath(*hostKey),
+		wish.WithMiddleware(
+			bm.Middleware(func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+				player, err := store.ForPlayer(s.User())
+				if err != nil {
+					wish.Fatalln(s, "Error:", err)
+					return nil, nil
+				}
+				sessionOpts := opts
+				sessionOpts.Store = player
+				sessionOpts.Quiet = true // The bell would ring on the server, not the player's terminal
+				return modelWrapper{game.NewModel(sessionOpts)}, []tea.ProgramOption{tea.WithAltScreen()}
+			}),
+			activeterm.Middleware(), // The game needs a terminal, so sessions without one are turned away
+			logging.Middleware(),
+		),
+	)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+	fmt.Printf("Serving flappy-bird-tui over SSH on %s, saving to %s\n", addr, *dataDir)
+
+	select {
+	case err := <-errs:
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	case <-done:
+	}
+
+	fmt.Println("Shutting down...")
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+		fmt.Printf("Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
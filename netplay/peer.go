@@ -0,0 +1,229 @@
+package netplay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultPort      = "7777"           // Port used when none is given
+	protocolVersion  = 1                // Bumped whenever the messages change
+	handshakeTimeout = 10 * time.Second // Longest wait for the other side's hello once connected
+	writeTimeout     = 5 * time.Second  // Longest a message may take to send before the match is given up
+)
+
+// ErrOpponentLeft is reported once the other side closes the connection
+var ErrOpponentLeft = errors.New("opponent left the match")
+
+// Message types
+const (
+	msgHello = "hello" // First message from each side
+	msgInput = "input" // A player's input for one tick
+	msgHash  = "hash"  // Checksum of both worlds at a tick
+)
+
+// Setup holds the settings of a match, picked by the host
+type Setup struct {
+	Seed       uint64 `json:"seed"`
+	Difficulty string `json:"difficulty"`
+	Hitbox     string `json:"hitbox"`
+	PowerUps   bool   `json:"power_ups,omitempty"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+}
+
+// message is one line of the protocol, sent as JSON
+type message struct {
+	Type     string `json:"type"`
+	Protocol int    `json:"protocol,omitempty"`
+	Version  string `json:"version,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Setup    *Setup `json:"setup,omitempty"`
+	Tick     int    `json:"tick,omitempty"`
+	Jump     bool   `json:"jump,omitempty"`
+	Hash     uint64 `json:"hash,omitempty"`
+}
+
+// Peer is the connection to the other player
+// Messages are read in the background and kept until the game asks for them
+type Peer struct {
+	Name    string // Opponent's name
+	Setup   Setup  // Settings of the match
+	Hosting bool   // Whether this side hosts the match
+
+	conn   net.Conn
+	enc    *json.Encoder
+	dec    *json.Decoder
+	mu     sync.Mutex
+	inputs map[int]bool   // Opponent's inputs not yet used, by tick
+	hashes map[int]uint64 // Opponent's hashes not yet compared, by tick
+	err    error          // First error on the connection
+}
+
+// Host waits for an opponent to connect and sends them the match setup
+func Host(ln net.Listener, name, version string, setup Setup) (*Peer, error) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	p := newPeer(conn)
+	p.Hosting = true
+	p.Setup = setup
+	if _, err := p.handshake(message{Type: msgHello, Protocol: protocolVersion, Version: version, Name: name, Setup: &setup}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	go p.read()
+	return p, nil
+}
+
+// Join connects to a host and receives the match setup
+// The default port is used if addr doesn't name one
+func Join(addr, name, version string) (*Peer, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, DefaultPort)
+	}
+	conn, err := net.DialTimeout("tcp", addr, handshakeTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	p := newPeer(conn)
+	hello, err := p.handshake(message{Type: msgHello, Protocol: protocolVersion, Version: version, Name: name})
+	if err == nil && hello.Setup == nil {
+		err = errors.New("host sent no match setup")
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	p.Setup = *hello.Setup
+	go p.read()
+	return p, nil
+}
+
+// newPeer wraps a fresh connection
+func newPeer(conn net.Conn) *Peer {
+	return &Peer{
+		conn:   conn,
+		enc:    json.NewEncoder(conn),
+		dec:    json.NewDecoder(conn),
+		inputs: map[int]bool{},
+		hashes: map[int]uint64{},
+	}
+}
+
+// handshake exchanges hellos and checks both sides run the same game
+// Worlds only stay in sync when both sides simulate them with the same code
+func (p *Peer) handshake(hello message) (message, error) {
+	p.conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	defer p.conn.SetDeadline(time.Time{})
+
+	if err := p.enc.Encode(hello); err != nil {
+		return message{}, err
+	}
+	var theirs message
+	if err := p.dec.Decode(&theirs); err != nil {
+		return message{}, err
+	}
+
+	switch {
+	case theirs.Type != msgHello:
+		return message{}, fmt.Errorf("unexpected %q message from opponent", theirs.Type)
+	case theirs.Protocol != protocolVersion:
+		return message{}, fmt.Errorf("opponent speaks protocol %d, this game speaks %d", theirs.Protocol, protocolVersion)
+	case theirs.Version != hello.Version:
+		return message{}, fmt.Errorf("opponent runs version %s, this game is %s", theirs.Version, hello.Version)
+	}
+	p.Name = theirs.Name
+	return theirs, nil
+}
+
+// read stores the opponent's messages until the connection fails
+func (p *Peer) read() {
+	for {
+		var msg message
+		if err := p.dec.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = ErrOpponentLeft
+			}
+			p.fail(err)
+			return
+		}
+
+		p.mu.Lock()
+		switch msg.Type {
+		case msgInput:
+			p.inputs[msg.Tick] = msg.Jump
+		case msgHash:
+			p.hashes[msg.Tick] = msg.Hash
+		}
+		p.mu.Unlock()
+	}
+}
+
+// fail records the first error on the connection
+func (p *Peer) fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// send writes a message, recording any error for Err
+// The deadline keeps an opponent who stopped reading from freezing the game
+func (p *Peer) send(msg message) {
+	p.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err := p.enc.Encode(msg); err != nil {
+		p.fail(err)
+	}
+}
+
+// SendInput tells the opponent whether this side jumps on a tick
+func (p *Peer) SendInput(tick int, jump bool) {
+	p.send(message{Type: msgInput, Tick: tick, Jump: jump})
+}
+
+// SendHash tells the opponent the checksum of both worlds at a tick
+func (p *Peer) SendHash(tick int, hash uint64) {
+	p.send(message{Type: msgHash, Tick: tick, Hash: hash})
+}
+
+// Input returns the opponent's input for a tick, ok is false if it hasn't arrived yet
+// Each input is returned once
+func (p *Peer) Input(tick int) (jump, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	jump, ok = p.inputs[tick]
+	delete(p.inputs, tick)
+	return jump, ok
+}
+
+// Hash returns the opponent's checksum for a tick, ok is false if it hasn't arrived yet
+// Each hash is returned once
+func (p *Peer) Hash(tick int) (hash uint64, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	hash, ok = p.hashes[tick]
+	delete(p.hashes, tick)
+	return hash, ok
+}
+
+// Err returns the first error on the connection, nil while it is healthy
+func (p *Peer) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// Close ends the connection
+func (p *Peer) Close() error {
+	return p.conn.Close()
+}
@@ -0,0 +1,177 @@
+package netplay
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/takish/flappy-bird-tui/domain"
+)
+
+// connect hosts a match on a free localhost port and joins it
+func connect(t *testing.T, setup Setup, hostVersion, joinVersion string) (host, join *Peer, hostErr, joinErr error) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	done := make(chan struct{})
+	go func() {
+		host, hostErr = Host(ln, "ann", hostVersion, setup)
+		close(done)
+	}()
+	join, joinErr = Join(ln.Addr().String(), "bob", joinVersion)
+	<-done
+
+	t.Cleanup(func() {
+		for _, p := range []*Peer{host, join} {
+			if p != nil {
+				p.Close()
+			}
+		}
+	})
+	return host, join, hostErr, joinErr
+}
+
+// waitFor polls until ok returns true, failing the test if it takes too long
+func waitFor(t *testing.T, what string, ok func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !ok() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	setup := Setup{Seed: 42, Difficulty: "Hard", Hitbox: "Forgiving", PowerUps: true, Width: 40, Height: 24}
+	host, join, hostErr, joinErr := connect(t, setup, "v1", "v1")
+	if hostErr != nil || joinErr != nil {
+		t.Fatalf("host: %v, join: %v", hostErr, joinErr)
+	}
+
+	if !host.Hosting || join.Hosting {
+		t.Errorf("Hosting = %v on the host and %v on the joiner", host.Hosting, join.Hosting)
+	}
+	if join.Setup != setup {
+		t.Errorf("joiner got setup %+v, want %+v", join.Setup, setup)
+	}
+	if host.Name != "bob" || join.Name != "ann" {
+		t.Errorf("host sees %q and joiner sees %q", host.Name, join.Name)
+	}
+}
+
+func TestHandshakeVersionMismatch(t *testing.T) {
+	_, _, hostErr, joinErr := connect(t, Setup{}, "v1", "v2")
+	if hostErr == nil || joinErr == nil {
+		t.Errorf("host: %v, join: %v, want both to refuse the match", hostErr, joinErr)
+	}
+}
+
+func TestLockstepStaysInSync(t *testing.T) {
+	const hashEvery = 60
+
+	setup := Setup{Seed: 7, Difficulty: "Normal", Hitbox: "Precise", PowerUps: true, Width: 40, Height: 24}
+	host, join, hostErr, joinErr := connect(t, setup, "v1", "v1")
+	if hostErr != nil || joinErr != nil {
+		t.Fatalf("host: %v, join: %v", hostErr, joinErr)
+	}
+
+	// Each side simulates both birds, its own first
+	type side struct {
+		peer        *Peer
+		own, theirs *domain.World
+	}
+	newWorld := func(s Setup) *domain.World {
+		return domain.NewWorld(domain.Config{
+			Seed:       s.Seed,
+			Mode:       domain.ModeVersus,
+			Difficulty: domain.ParseDifficulty(s.Difficulty),
+			Hitbox:     domain.ParseHitboxMode(s.Hitbox),
+			PowerUps:   s.PowerUps,
+			Width:      s.Width,
+			Height:     s.Height,
+		})
+	}
+	sides := []*side{
+		{peer: host, own: newWorld(host.Setup), theirs: newWorld(host.Setup)},
+		{peer: join, own: newWorld(join.Setup), theirs: newWorld(join.Setup)},
+	}
+	// hash checksums both worlds with the host's bird first, as both sides do
+	hash := func(s *side) uint64 {
+		first, second := s.own, s.theirs
+		if !s.peer.Hosting {
+			first, second = second, first
+		}
+		return first.Hash()*31 + second.Hash()
+	}
+
+	checked := 0
+	for tick := 1; tick <= 600; tick++ {
+		// The players flap on different beats, so the birds fly apart
+		jumps := []bool{tick%9 == 0, tick%13 == 0}
+		for i, s := range sides {
+			s.peer.SendInput(tick, jumps[i])
+		}
+
+		for i, s := range sides {
+			var remote, ok bool
+			waitFor(t, "the opponent's input", func() bool {
+				remote, ok = s.peer.Input(tick)
+				return ok || s.peer.Err() != nil
+			})
+			if err := s.peer.Err(); err != nil {
+				t.Fatalf("tick %d: %v", tick, err)
+			}
+			if remote != jumps[1-i] {
+				t.Fatalf("tick %d: side %d got jump %v, want %v", tick, i, remote, jumps[1-i])
+			}
+			s.own.Step(domain.Input{Jump: jumps[i]})
+			s.theirs.Step(domain.Input{Jump: remote})
+		}
+
+		if tick%hashEvery != 0 {
+			continue
+		}
+		for _, s := range sides {
+			s.peer.SendHash(tick, hash(s))
+		}
+		for i, s := range sides {
+			var theirs uint64
+			var ok bool
+			waitFor(t, "the opponent's hash", func() bool {
+				theirs, ok = s.peer.Hash(tick)
+				return ok
+			})
+			if mine := hash(s); theirs != mine {
+				t.Fatalf("tick %d: side %d has hash %x, opponent sent %x", tick, i, mine, theirs)
+			}
+		}
+		checked++
+	}
+
+	if checked != 10 {
+		t.Errorf("compared hashes %d times, want 10", checked)
+	}
+	if sides[0].own.Hash() == sides[1].own.Hash() {
+		t.Error("both birds flew the same way, so the checksums prove nothing")
+	}
+}
+
+func TestOpponentLeft(t *testing.T) {
+	host, join, hostErr, joinErr := connect(t, Setup{}, "v1", "v1")
+	if hostErr != nil || joinErr != nil {
+		t.Fatalf("host: %v, join: %v", hostErr, joinErr)
+	}
+
+	join.Close()
+	waitFor(t, "the host to notice", func() bool { return host.Err() != nil })
+	if err := host.Err(); !errors.Is(err, ErrOpponentLeft) {
+		t.Errorf("Err = %v, want %v", err, ErrOpponentLeft)
+	}
+}
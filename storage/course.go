@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Course is a handcrafted course file
+type Course struct {
+	Name   string       `json:"name,omitempty"`
+	Height int          `json:"height,omitempty"` // Playfield height, defaults to 24
+	Pipes  []CoursePipe `json:"pipes"`
+	End    *CourseEnd   `json:"end,omitempty"` // Finish line after the last pipe
+}
+
+// CoursePipe describes one pipe of a course file
+type CoursePipe struct {
+	Spacing int `json:"spacing"` // Distance from the previous pipe, or from the bird for the first pipe
+	GapY    int `json:"gap_y"`
+	GapSize int `json:"gap_size"`
+	Width   int `json:"width,omitempty"` // Defaults to the normal pipe width
+}
+
+// CourseEnd marks the finish line of a course
+type CourseEnd struct {
+	Spacing int `json:"spacing"` // Distance from the last pipe
+}
+
+// LoadCourse loads a course from a file path
+func LoadCourse(path string) (*Course, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Course
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// SaveCourse writes a course to a file path
+func SaveCourse(path string, c Course) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,112 @@
+package storage
+
+import "sort"
+
+// DailyResult is one player's counted run of a daily challenge
+// The player's name is the one of the embedded high score
+type DailyResult struct {
+	HighScore
+	Abandoned bool `json:"abandoned,omitempty"` // Run was started but never finished, e.g. quit before the crash
+}
+
+const dailyFile = "daily.json"
+
+// LoadDailyBoards loads the daily leaderboards keyed by date
+func (s *Store) LoadDailyBoards() (map[string][]DailyResult, error) {
+	// No daily results yet unless the file says otherwise
+	boards := map[string][]DailyResult{}
+	if err := s.load(dailyFile, &boards); err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// SaveDailyBoards saves the daily leaderboards to disk
+func (s *Store) SaveDailyBoards(boards map[string][]DailyResult) error {
+	return s.save(dailyFile, boards)
+}
+
+// AddDailyResult adds the player's run to a date's board on disk and returns every board
+// The run is recorded under the store's player name
+// Only the first run of the day counts, so later runs aren't saved and false is returned
+// The boards are reloaded first, so results saved by other players in the meantime are kept
+func (s *Store) AddDailyResult(date string, result DailyResult) (map[string][]DailyResult, bool, error) {
+	defer s.lock()()
+
+	result.Player = s.Player
+	boards := map[string][]DailyResult{}
+	if err := s.readFile(dailyFile, &boards); err != nil {
+		return nil, false, err
+	}
+	boards, counted := AddDailyResult(boards, date, result)
+	if !counted {
+		return boards, false, nil
+	}
+	if err := s.writeFile(dailyFile, boards); err != nil {
+		return nil, false, err
+	}
+	return boards, true, nil
+}
+
+// StartDailyRun records that the player started a date's daily run before it is played
+// Only the first run of the day counts, and it is kept as abandoned until AddDailyResult replaces it,
+// so quitting the run doesn't earn another try; returns whether the run counts
+func (s *Store) StartDailyRun(date string) (map[string][]DailyResult, bool, error) {
+	return s.AddDailyResult(date, DailyResult{Abandoned: true})
+}
+
+// HasDailyResult checks if a player already has a counted run for a date
+func HasDailyResult(boards map[string][]DailyResult, date, player string) bool {
+	return dailyResultIndex(boards[date], player) >= 0
+}
+
+// dailyResultIndex returns the position of a player's result on a board, -1 if there is none
+func dailyResultIndex(board []DailyResult, player string) int {
+	for i, r := range board {
+		if r.Player == player {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddDailyResult adds a player's run to a date's board
+// Only the first run of the day counts: a finished run replaces the player's abandoned start of it,
+// and any other run is ignored and false is returned
+func AddDailyResult(boards map[string][]DailyResult, date string, result DailyResult) (map[string][]DailyResult, bool) {
+	board := append([]DailyResult{}, boards[date]...)
+	if i := dailyResultIndex(board, result.Player); i >= 0 {
+		if !board[i].Abandoned || result.Abandoned {
+			return boards, false
+		}
+		board = append(board[:i], board[i+1:]...)
+	}
+
+	// Copy the map so the caller's boards stay untouched
+	updated := make(map[string][]DailyResult, len(boards)+1)
+	for d, board := range boards {
+		updated[d] = board
+	}
+
+	// Abandoned runs go below every finished one
+	board = append(board, result)
+	sort.SliceStable(board, func(i, j int) bool {
+		if board[i].Abandoned != board[j].Abandoned {
+			return board[j].Abandoned
+		}
+		return rankedBefore(board[i].HighScore, board[j].HighScore)
+	})
+	updated[date] = board
+
+	return updated, true
+}
+
+// DailyDates returns the dates that have results, oldest first
+func DailyDates(boards map[string][]DailyResult) []string {
+	dates := make([]string, 0, len(boards))
+	for d := range boards {
+		dates = append(dates, d)
+	}
+	sort.Strings(dates)
+	return dates
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddDailyResult(t *testing.T) {
+	const date = "2026-10-16"
+	finished := func(player string, score int) DailyResult {
+		return DailyResult{HighScore: HighScore{Player: player, Score: score, Duration: time.Duration(score) * time.Second}}
+	}
+	started := func(player string) DailyResult {
+		return DailyResult{HighScore: HighScore{Player: player}, Abandoned: true}
+	}
+
+	tests := []struct {
+		name        string
+		board       []DailyResult
+		add         DailyResult
+		wantCounted bool
+		wantOrder   []string
+	}{
+		{name: "first run", add: finished("ann", 3), wantCounted: true, wantOrder: []string{"ann"}},
+		{name: "start of the first run", add: started("ann"), wantCounted: true, wantOrder: []string{"ann"}},
+		{name: "finishing the started run", board: []DailyResult{started("ann")}, add: finished("ann", 3), wantCounted: true, wantOrder: []string{"ann"}},
+		{name: "second start", board: []DailyResult{started("ann")}, add: started("ann"), wantOrder: []string{"ann"}},
+		{name: "second finished run", board: []DailyResult{finished("ann", 3)}, add: finished("ann", 9), wantOrder: []string{"ann"}},
+		{name: "ranked by score", board: []DailyResult{finished("ann", 3)}, add: finished("bob", 5), wantCounted: true, wantOrder: []string{"bob", "ann"}},
+		{name: "abandoned runs last", board: []DailyResult{started("ann")}, add: finished("bob", 0), wantCounted: true, wantOrder: []string{"bob", "ann"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boards := map[string][]DailyResult{date: tt.board}
+			updated, counted := AddDailyResult(boards, date, tt.add)
+			if counted != tt.wantCounted {
+				t.Errorf("counted = %v, want %v", counted, tt.wantCounted)
+			}
+
+			board := updated[date]
+			if len(board) != len(tt.wantOrder) {
+				t.Fatalf("board = %+v, want %v", board, tt.wantOrder)
+			}
+			for i, r := range board {
+				if r.Player != tt.wantOrder[i] {
+					t.Errorf("place %d: %s, want %s", i+1, r.Player, tt.wantOrder[i])
+				}
+			}
+			if len(boards[date]) != len(tt.board) {
+				t.Error("the caller's board was changed")
+			}
+		})
+	}
+}
@@ -1,15 +1,13 @@
 package storage
 
 import (
-	"encoding/json"
-	"os"
-	"path/filepath"
 	"sort"
 	"time"
 )
 
 // HighScore represents a saved high score with statistics
 type HighScore struct {
+	Player     string        `json:"player,omitempty"` // Name the score was recorded under, empty for scores before names
 	Score      int           `json:"score"`
 	Duration   time.Duration `json:"duration"`
 	Date       time.Time     `json:"date"`
@@ -18,66 +16,47 @@ type HighScore struct {
 	MinHeight  int           `json:"min_height"`
 	AvgHeight  float64       `json:"avg_height"`
 	Difficulty string        `json:"difficulty"`
+	Mode       string        `json:"mode,omitempty"`   // Game mode, empty for scores before modes existed
+	Hitbox     string        `json:"hitbox,omitempty"` // Hitbox mode, empty for scores before hitbox modes
+	Seed       uint64        `json:"seed,omitempty"`
+	PowerUps   bool          `json:"power_ups,omitempty"`     // Whether power-up items spawned
+	Replay     string        `json:"replay,omitempty"`        // Replay file name in the replays directory
+	Digest     string        `json:"replay_digest,omitempty"` // Digest of the replay, checked by verify
 }
 
-const configDir = ".flappy-bird-tui"
 const highScoreFile = "highscore.json"
 const rankingsFile = "rankings.json"
 const maxRankings = 10
 
-// getConfigPath returns the path to the config directory
-func getConfigPath() (string, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "", err
-	}
-	return filepath.Join(home, configDir), nil
-}
-
-// LoadHighScore loads the high score from disk
-func LoadHighScore() (*HighScore, error) {
-	configPath, err := getConfigPath()
-	if err != nil {
-		return nil, err
-	}
-
-	filePath := filepath.Join(configPath, highScoreFile)
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			// No high score yet
-			return &HighScore{}, nil
-		}
-		return nil, err
-	}
-
-	var hs HighScore
-	if err := json.Unmarshal(data, &hs); err != nil {
+// LoadHighScore loads the player's high score
+func (s *Store) LoadHighScore() (*HighScore, error) {
+	// No high score yet unless the file says otherwise
+	hs := &HighScore{}
+	if err := s.loadPersonal(highScoreFile, hs); err != nil {
 		return nil, err
 	}
-
-	return &hs, nil
+	return hs, nil
 }
 
-// SaveHighScore saves the high score to disk
-func SaveHighScore(hs HighScore) error {
-	configPath, err := getConfigPath()
-	if err != nil {
-		return err
-	}
-
-	// Create config directory if it doesn't exist
-	if err := os.MkdirAll(configPath, 0755); err != nil {
-		return err
-	}
+// SaveHighScore saves the player's high score to disk
+func (s *Store) SaveHighScore(hs HighScore) error {
+	return s.savePersonal(highScoreFile, hs)
+}
 
-	data, err := json.MarshalIndent(hs, "", "  ")
-	if err != nil {
-		return err
+// AddHighScore saves a score as the player's high score on disk if it beats the one there
+// Returns the high score on disk afterwards and whether the score became it
+func (s *Store) AddHighScore(newScore HighScore) (*HighScore, bool, error) {
+	hs := &HighScore{}
+	isNew := false
+	if err := s.updatePersonal(highScoreFile, hs, func() error {
+		if isNew = IsNewHighScore(newScore.Score, hs); isNew {
+			*hs = newScore
+		}
+		return nil
+	}); err != nil {
+		return nil, false, err
 	}
-
-	filePath := filepath.Join(configPath, highScoreFile)
-	return os.WriteFile(filePath, data, 0644)
+	return hs, isNew, nil
 }
 
 // IsNewHighScore checks if the current score is a new high score
@@ -86,49 +65,42 @@ func IsNewHighScore(currentScore int, highScore *HighScore) bool {
 }
 
 // LoadRankings loads the top 10 rankings from disk
-func LoadRankings() ([]HighScore, error) {
-	configPath, err := getConfigPath()
-	if err != nil {
-		return nil, err
-	}
-
-	filePath := filepath.Join(configPath, rankingsFile)
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			// No rankings yet
-			return []HighScore{}, nil
-		}
-		return nil, err
-	}
-
-	var rankings []HighScore
-	if err := json.Unmarshal(data, &rankings); err != nil {
+func (s *Store) LoadRankings() ([]HighScore, error) {
+	// No rankings yet unless the file says otherwise
+	rankings := []HighScore{}
+	if err := s.load(rankingsFile, &rankings); err != nil {
 		return nil, err
 	}
-
 	return rankings, nil
 }
 
 // SaveRankings saves the rankings to disk
-func SaveRankings(rankings []HighScore) error {
-	configPath, err := getConfigPath()
-	if err != nil {
-		return err
-	}
+func (s *Store) SaveRankings(rankings []HighScore) error {
+	return s.save(rankingsFile, rankings)
+}
 
-	// Create config directory if it doesn't exist
-	if err := os.MkdirAll(configPath, 0755); err != nil {
-		return err
-	}
+// AddRanking adds a score to the rankings on disk and returns them with the score's rank (1-based)
+// The rankings are reloaded first, so scores saved by other players in the meantime are kept
+func (s *Store) AddRanking(newScore HighScore) ([]HighScore, int, error) {
+	defer s.lock()()
 
-	data, err := json.MarshalIndent(rankings, "", "  ")
-	if err != nil {
-		return err
+	rankings := []HighScore{}
+	if err := s.readFile(rankingsFile, &rankings); err != nil {
+		return nil, 0, err
 	}
+	rankings, rank := AddToRankings(rankings, newScore)
+	if err := s.writeFile(rankingsFile, rankings); err != nil {
+		return nil, 0, err
+	}
+	return rankings, rank, nil
+}
 
-	filePath := filepath.Join(configPath, rankingsFile)
-	return os.WriteFile(filePath, data, 0644)
+// rankedBefore orders scores by score (descending), then by duration (ascending for same score)
+func rankedBefore(a, b HighScore) bool {
+	if a.Score == b.Score {
+		return a.Duration < b.Duration
+	}
+	return a.Score > b.Score
 }
 
 // AddToRankings adds a new score to the rankings and returns the rank (1-based)
@@ -137,12 +109,8 @@ func AddToRankings(rankings []HighScore, newScore HighScore) ([]HighScore, int)
 	// Add new score
 	rankings = append(rankings, newScore)
 
-	// Sort by score (descending), then by duration (ascending for same score)
 	sort.Slice(rankings, func(i, j int) bool {
-		if rankings[i].Score == rankings[j].Score {
-			return rankings[i].Duration < rankings[j].Duration
-		}
-		return rankings[i].Score > rankings[j].Score
+		return rankedBefore(rankings[i], rankings[j])
 	})
 
 	// Find rank of new score
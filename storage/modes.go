@@ -0,0 +1,49 @@
+package storage
+
+const modeRankingsFile = "mode_rankings.json"
+
+// LoadModeRankings loads the leaderboards of the other game modes, keyed by mode
+func (s *Store) LoadModeRankings() (map[string][]HighScore, error) {
+	// No mode rankings yet unless the file says otherwise
+	boards := map[string][]HighScore{}
+	if err := s.load(modeRankingsFile, &boards); err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// SaveModeRankings saves the mode leaderboards to disk
+func (s *Store) SaveModeRankings(boards map[string][]HighScore) error {
+	return s.save(modeRankingsFile, boards)
+}
+
+// AddModeRanking adds a score to the leaderboard of a mode on disk
+// Returns every mode's leaderboard and the score's rank (1-based), 0 if it didn't make the leaderboard
+// The leaderboards are reloaded first, so scores saved by other players in the meantime are kept
+func (s *Store) AddModeRanking(key string, newScore HighScore) (map[string][]HighScore, int, error) {
+	defer s.lock()()
+
+	boards := map[string][]HighScore{}
+	if err := s.readFile(modeRankingsFile, &boards); err != nil {
+		return nil, 0, err
+	}
+	boards, rank := AddToModeRankings(boards, key, newScore)
+	if err := s.writeFile(modeRankingsFile, boards); err != nil {
+		return nil, 0, err
+	}
+	return boards, rank, nil
+}
+
+// AddToModeRankings adds a score to the leaderboard of a mode and returns the rank (1-based)
+// Returns 0 if the score didn't make it to the leaderboard
+func AddToModeRankings(boards map[string][]HighScore, key string, newScore HighScore) (map[string][]HighScore, int) {
+	// Copy the map so the caller's boards stay untouched
+	updated := make(map[string][]HighScore, len(boards)+1)
+	for k, board := range boards {
+		updated[k] = board
+	}
+
+	board, rank := AddToRankings(append([]HighScore{}, boards[key]...), newScore)
+	updated[key] = board
+	return updated, rank
+}
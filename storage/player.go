@@ -0,0 +1,17 @@
+package storage
+
+import (
+	"os"
+	"os/user"
+)
+
+// PlayerName returns the name results are recorded under
+func PlayerName() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	return "player"
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Replay holds everything needed to play a run back exactly
+type Replay struct {
+	Version    string    `json:"version"`             // Build version that recorded the run
+	Seed       uint64    `json:"seed"`                // Pipe seed of the run
+	Mode       string    `json:"mode,omitempty"`      // Game mode name, empty for replays before modes existed
+	Difficulty string    `json:"difficulty"`          // Difficulty name
+	Hitbox     string    `json:"hitbox"`              // Hitbox mode name
+	Target     int       `json:"target,omitempty"`    // Pipes to pass in a speedrun
+	PowerUps   bool      `json:"power_ups,omitempty"` // Whether power-up items spawned
+	Width      int       `json:"width"`               // Terminal width when the run started
+	Height     int       `json:"height"`              // Terminal height when the run started
+	Jumps      []int     `json:"jumps"`               // Tick index of every jump
+	Ticks      int       `json:"ticks"`               // Number of ticks until game over
+	Score      int       `json:"score"`
+	Date       time.Time `json:"date"`
+}
+
+const replaysDir = "replays"
+
+// getReplaysPath returns the path to the replays directory
+func (s *Store) getReplaysPath() (string, error) {
+	configPath, err := s.dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configPath, replaysDir), nil
+}
+
+// SaveReplay writes a replay to the replays directory and returns its file name
+func (s *Store) SaveReplay(r Replay) (string, error) {
+	replaysPath, err := s.getReplaysPath()
+	if err != nil {
+		return "", err
+	}
+
+	// Create replays directory if it doesn't exist
+	if err := os.MkdirAll(replaysPath, 0755); err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	// Players of a shared store save into the same directory, so their names keep the files apart
+	name := "replay-" + r.Date.Format("20060102-150405.000") + ".json"
+	if s.shared {
+		name = "replay-" + s.Player + "-" + r.Date.Format("20060102-150405.000") + ".json"
+	}
+	if err := os.WriteFile(filepath.Join(replaysPath, name), data, 0644); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// LoadReplay loads a replay from a file path
+func LoadReplay(path string) (*Replay, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var r Replay
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ReplayPath returns the full path of a replay saved by SaveReplay
+// Names come from score files that may have been edited, so only bare file names are accepted
+func (s *Store) ReplayPath(name string) (string, error) {
+	if name == "" || name == "." || name == ".." || filepath.Base(name) != name {
+		return "", fmt.Errorf("invalid replay name %q", name)
+	}
+	replaysPath, err := s.getReplaysPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(replaysPath, name), nil
+}
+
+// Digest returns a fingerprint of the replay used to tie a score to its inputs
+func (r Replay) Digest() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
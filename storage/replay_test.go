@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReplayRoundTrip(t *testing.T) {
+	s := &Store{Dir: t.TempDir()}
+	r := Replay{
+		Version:    "test",
+		Seed:       42,
+		Mode:       "Classic",
+		Difficulty: "Normal",
+		Hitbox:     "Precise",
+		Width:      80,
+		Height:     24,
+		Jumps:      []int{3, 40, 77},
+		Ticks:      120,
+		Score:      1,
+		Date:       time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	name, err := s.SaveReplay(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := s.ReplayPath(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadReplay(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(*loaded, r) {
+		t.Errorf("loaded %+v, saved %+v", *loaded, r)
+	}
+	if loaded.Digest() != r.Digest() {
+		t.Error("digest changed after saving and loading")
+	}
+}
+
+func TestReplayDigestCoversInputs(t *testing.T) {
+	r := Replay{Seed: 1, Jumps: []int{5, 10}, Ticks: 50, Score: 2}
+	tampered := r
+	tampered.Jumps = []int{5, 11}
+
+	if r.Digest() == tampered.Digest() {
+		t.Error("changing a jump kept the same digest")
+	}
+}
+
+func TestReplayPathRejectsPaths(t *testing.T) {
+	s := &Store{Dir: t.TempDir()}
+	for _, name := range []string{"", ".", "..", "../rankings.json", "sub/replay.json", "/etc/passwd"} {
+		if _, err := s.ReplayPath(name); err == nil {
+			t.Errorf("ReplayPath(%q) was accepted", name)
+		}
+	}
+	if _, err := s.ReplayPath("replay-20260102-030405.000.json"); err != nil {
+		t.Errorf("bare file name rejected: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package storage
+
+import "time"
+
+const splitsFile = "splits.json"
+
+// LoadBestSplits loads the split times of the player's fastest speedruns, keyed by target and difficulty
+func (s *Store) LoadBestSplits() (map[string][]time.Duration, error) {
+	// No speedruns finished yet unless the file says otherwise
+	splits := map[string][]time.Duration{}
+	if err := s.loadPersonal(splitsFile, &splits); err != nil {
+		return nil, err
+	}
+	return splits, nil
+}
+
+// SaveBestSplits saves the player's best split times to disk
+func (s *Store) SaveBestSplits(splits map[string][]time.Duration) error {
+	return s.savePersonal(splitsFile, splits)
+}
+
+// AddBestSplits stores the splits of a finished run if it beat the best time for its key
+// Returns whether the splits were stored
+func AddBestSplits(splits map[string][]time.Duration, key string, run []time.Duration) (map[string][]time.Duration, bool) {
+	best := splits[key]
+	if len(run) == 0 || (len(best) > 0 && best[len(best)-1] <= run[len(run)-1]) {
+		return splits, false
+	}
+
+	// Copy the map so the caller's splits stay untouched
+	updated := make(map[string][]time.Duration, len(splits)+1)
+	for k, s := range splits {
+		updated[k] = s
+	}
+	updated[key] = append([]time.Duration{}, run...)
+	return updated, true
+}
+
+// AddBestSplits stores the splits of a finished run on disk if it beat the player's best time for its key
+// Returns every best split of the player and whether the run's splits were stored
+func (s *Store) AddBestSplits(key string, run []time.Duration) (map[string][]time.Duration, bool, error) {
+	splits := map[string][]time.Duration{}
+	stored := false
+	if err := s.updatePersonal(splitsFile, &splits, func() error {
+		splits, stored = AddBestSplits(splits, key, run)
+		return nil
+	}); err != nil {
+		return nil, false, err
+	}
+	return splits, stored, nil
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAddBestSplits(t *testing.T) {
+	const key = "Speedrun 25 Normal"
+	s := time.Second
+	best := map[string][]time.Duration{key: {10 * s, 20 * s, 30 * s}}
+
+	tests := []struct {
+		name       string
+		splits     map[string][]time.Duration
+		run        []time.Duration
+		wantStored bool
+	}{
+		{name: "first run", splits: map[string][]time.Duration{}, run: []time.Duration{12 * s, 25 * s}, wantStored: true},
+		{name: "faster run", splits: best, run: []time.Duration{11 * s, 22 * s, 29 * s}, wantStored: true},
+		{name: "slower run", splits: best, run: []time.Duration{9 * s, 19 * s, 31 * s}, wantStored: false},
+		{name: "tied run", splits: best, run: []time.Duration{9 * s, 19 * s, 30 * s}, wantStored: false},
+		{name: "no splits", splits: best, run: nil, wantStored: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := slices.Clone(tt.splits[key])
+			updated, stored := AddBestSplits(tt.splits, key, tt.run)
+
+			if stored != tt.wantStored {
+				t.Fatalf("stored = %v, want %v", stored, tt.wantStored)
+			}
+			// Only the final time decides, and the caller's map is never changed
+			want := before
+			if stored {
+				want = tt.run
+			}
+			if !slices.Equal(updated[key], want) {
+				t.Errorf("best splits = %v, want %v", updated[key], want)
+			}
+			if !slices.Equal(tt.splits[key], before) {
+				t.Errorf("caller's splits changed to %v", tt.splits[key])
+			}
+		})
+	}
+}
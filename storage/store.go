@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const configDir = ".flappy-bird-tui"
+
+// errNoDir is returned when a store has nowhere to keep its files
+var errNoDir = errors.New("no directory to save in")
+
+// Store is where scores, replays and progress are kept
+// A shared store holds every player of a server: leaderboards are common to all of them,
+// and personal files such as the high score and wallet keep one entry per player
+type Store struct {
+	Dir    string // Directory holding the files, empty if there is nowhere to save
+	Player string // Name results are recorded under
+
+	shared bool        // Files are shared between players
+	mu     *sync.Mutex // Serialises access to the files between the players of a shared store
+}
+
+// Home returns the store of the user running the game, kept in ~/.flappy-bird-tui
+func Home() *Store {
+	s := &Store{Player: PlayerName(), mu: &sync.Mutex{}}
+	if home, err := os.UserHomeDir(); err == nil {
+		s.Dir = filepath.Join(home, configDir)
+	}
+	return s
+}
+
+// ServerStore returns the store shared by every player of a server, kept in dir
+// Use ForPlayer to record results under a player's name
+func ServerStore(dir string) *Store {
+	return &Store{Dir: dir, shared: true, mu: &sync.Mutex{}}
+}
+
+// ForPlayer returns a view of the store that records results under a player's name
+// Every view shares the files and the lock of the store it came from
+func (s *Store) ForPlayer(player string) (*Store, error) {
+	// The name comes from the client and ends up in replay file names
+	if player == "" || player == "." || player == ".." || strings.ContainsAny(player, `/\`) {
+		return nil, fmt.Errorf("invalid player name %q", player)
+	}
+	view := *s
+	view.Player = player
+	return &view, nil
+}
+
+// Shared reports whether the store's leaderboards are shared with other players
+func (s *Store) Shared() bool {
+	return s.shared
+}
+
+// dir returns the directory holding the store's files
+func (s *Store) dir() (string, error) {
+	if s.Dir == "" {
+		return "", errNoDir
+	}
+	return s.Dir, nil
+}
+
+// lock takes the store's lock and returns the function that releases it
+// Stores built without a constructor have no lock, as nothing else uses their files
+func (s *Store) lock() func() {
+	if s.mu == nil {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// readFile decodes a file of the store into v, leaving v untouched if the file doesn't exist yet
+// The caller holds the lock
+func (s *Store) readFile(name string, v any) error {
+	configPath, err := s.dir()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(filepath.Join(configPath, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeFile encodes v into a file of the store
+// The caller holds the lock
+func (s *Store) writeFile(name string, v any) error {
+	configPath, err := s.dir()
+	if err != nil {
+		return err
+	}
+
+	// Create config directory if it doesn't exist
+	if err := os.MkdirAll(configPath, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it, so a crash never leaves a half-written file
+	filePath := filepath.Join(configPath, name)
+	tmp := filePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filePath)
+}
+
+// load reads a file of the store into v, leaving v untouched if it doesn't exist yet
+func (s *Store) load(name string, v any) error {
+	defer s.lock()()
+	return s.readFile(name, v)
+}
+
+// save writes v to a file of the store
+func (s *Store) save(name string, v any) error {
+	defer s.lock()()
+	return s.writeFile(name, v)
+}
+
+// loadPersonal reads the player's own data from a file of the store into v
+// In a shared store the file holds every player's data keyed by name
+func (s *Store) loadPersonal(name string, v any) error {
+	if !s.shared {
+		return s.load(name, v)
+	}
+	defer s.lock()()
+
+	var players map[string]json.RawMessage
+	if err := s.readFile(name, &players); err != nil {
+		return err
+	}
+	data, ok := players[s.Player]
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// savePersonal writes the player's own data to a file of the store
+// In a shared store the other players' data in the file is kept as it is
+func (s *Store) savePersonal(name string, v any) error {
+	if !s.shared {
+		return s.save(name, v)
+	}
+	defer s.lock()()
+
+	players := map[string]json.RawMessage{}
+	if err := s.readFile(name, &players); err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	players[s.Player] = data
+	return s.writeFile(name, players)
+}
+
+// updatePersonal reloads the player's own data from a file into v, lets change update it and saves it,
+// all under the lock so changes made by the player's other sessions in the meantime are kept
+// v must hold the zero value of the data; nothing is saved if change fails
+func (s *Store) updatePersonal(name string, v any, change func() error) error {
+	defer s.lock()()
+
+	if !s.shared {
+		if err := s.readFile(name, v); err != nil {
+			return err
+		}
+		if err := change(); err != nil {
+			return err
+		}
+		return s.writeFile(name, v)
+	}
+
+	players := map[string]json.RawMessage{}
+	if err := s.readFile(name, &players); err != nil {
+		return err
+	}
+	if data, ok := players[s.Player]; ok {
+		if err := json.Unmarshal(data, v); err != nil {
+			return err
+		}
+	}
+	if err := change(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	players[s.Player] = data
+	return s.writeFile(name, players)
+}
@@ -0,0 +1,237 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// serverPlayers returns views of one server store for each player
+func serverPlayers(t *testing.T, names ...string) []*Store {
+	t.Helper()
+	server := ServerStore(t.TempDir())
+	stores := make([]*Store, len(names))
+	for i, name := range names {
+		s, err := server.ForPlayer(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stores[i] = s
+	}
+	return stores
+}
+
+func TestForPlayerRejectsPaths(t *testing.T) {
+	server := ServerStore(t.TempDir())
+	for _, name := range []string{"", ".", "..", "../alice", `a\b`, "a/b"} {
+		if _, err := server.ForPlayer(name); err == nil {
+			t.Errorf("ForPlayer(%q) succeeded", name)
+		}
+	}
+}
+
+func TestServerStoreKeepsPersonalDataApart(t *testing.T) {
+	stores := serverPlayers(t, "ann", "bob")
+	ann, bob := stores[0], stores[1]
+
+	if err := ann.SaveHighScore(HighScore{Score: 12}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ann.SaveWallet(&Wallet{Coins: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bob.SaveWallet(&Wallet{Coins: 9}); err != nil {
+		t.Fatal(err)
+	}
+
+	if hs, err := bob.LoadHighScore(); err != nil || hs.Score != 0 {
+		t.Errorf("bob's high score = %+v, %v, want none", hs, err)
+	}
+	if hs, err := ann.LoadHighScore(); err != nil || hs.Score != 12 {
+		t.Errorf("ann's high score = %+v, %v, want 12", hs, err)
+	}
+	for s, want := range map[*Store]int{ann: 5, bob: 9} {
+		if w, err := s.LoadWallet(); err != nil || w.Coins != want {
+			t.Errorf("%s's wallet = %+v, %v, want %d coins", s.Player, w, err, want)
+		}
+	}
+}
+
+func TestServerStoreSharesLeaderboards(t *testing.T) {
+	stores := serverPlayers(t, "ann", "bob")
+	ann, bob := stores[0], stores[1]
+	now := time.Now()
+
+	if _, _, err := ann.AddRanking(HighScore{Player: "ann", Score: 3, Date: now}); err != nil {
+		t.Fatal(err)
+	}
+	rankings, rank, err := bob.AddRanking(HighScore{Player: "bob", Score: 7, Date: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rank != 1 || len(rankings) != 2 || rankings[1].Player != "ann" {
+		t.Errorf("bob ranked %d on %+v, want first ahead of ann", rank, rankings)
+	}
+
+	if _, _, err := ann.AddDailyResult("2026-10-16", DailyResult{HighScore: HighScore{Score: 4}}); err != nil {
+		t.Fatal(err)
+	}
+	boards, counted, err := bob.AddDailyResult("2026-10-16", DailyResult{HighScore: HighScore{Score: 2}})
+	if err != nil || !counted {
+		t.Fatalf("bob's daily run counted = %v, %v", counted, err)
+	}
+	if board := boards["2026-10-16"]; len(board) != 2 || board[0].Player != "ann" {
+		t.Errorf("daily board = %+v, want ann ahead of bob", board)
+	}
+
+	// Only the first run of the day counts, however many sessions a player has open
+	if _, counted, err := ann.AddDailyResult("2026-10-16", DailyResult{HighScore: HighScore{Score: 40}}); err != nil || counted {
+		t.Errorf("ann's second daily run counted = %v, %v", counted, err)
+	}
+}
+
+func TestServerStoreConcurrentWrites(t *testing.T) {
+	const players = 20
+
+	names := make([]string, players)
+	for i := range names {
+		names[i] = fmt.Sprintf("player%02d", i)
+	}
+	stores := serverPlayers(t, names...)
+
+	// Every player finishes a run at once, with a second session of the same player doing the same
+	var wg sync.WaitGroup
+	for i, s := range stores {
+		for session := 0; session < 2; session++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				score := HighScore{Player: s.Player, Score: i, Date: time.Now()}
+				if _, _, err := s.AddModeRanking("Zen", score); err != nil {
+					t.Error(err)
+				}
+				if _, _, err := s.AddDailyResult("2026-10-16", DailyResult{HighScore: score}); err != nil {
+					t.Error(err)
+				}
+				if err := s.SaveWallet(&Wallet{Coins: i}); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	boards, err := stores[0].LoadDailyBoards()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(boards["2026-10-16"]); got != players {
+		t.Errorf("daily board has %d results, want one for each of %d players", got, players)
+	}
+
+	modes, err := stores[0].LoadModeRankings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if board := modes["Zen"]; len(board) != maxRankings || board[0].Score != players-1 {
+		t.Errorf("zen leaderboard = %+v, want the top %d scores", board, maxRankings)
+	}
+
+	for i, s := range stores {
+		if w, err := s.LoadWallet(); err != nil || w.Coins != i {
+			t.Errorf("%s's wallet = %+v, %v, want %d coins", s.Player, w, err, i)
+		}
+	}
+}
+
+func TestLocalStoreFilesUnchanged(t *testing.T) {
+	// A player's own store keeps each file in its plain format, not keyed by name
+	s := &Store{Dir: t.TempDir(), Player: "tester"}
+	if err := s.SaveHighScore(HighScore{Score: 8}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.Dir, highScoreFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hs HighScore
+	if err := json.Unmarshal(data, &hs); err != nil || hs.Score != 8 {
+		t.Errorf("high score file holds %s", data)
+	}
+}
+
+func TestSharedReplayNames(t *testing.T) {
+	stores := serverPlayers(t, "ann", "bob")
+	date := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	// Two players finishing at the same moment don't overwrite each other's replay
+	names := map[string]bool{}
+	for _, s := range stores {
+		name, err := s.SaveReplay(Replay{Date: date})
+		if err != nil {
+			t.Fatal(err)
+		}
+		names[name] = true
+	}
+	if len(names) != 2 {
+		t.Errorf("replays saved as %v, want two files", names)
+	}
+}
+
+func TestSamePlayerSessionsKeepPersonalData(t *testing.T) {
+	for _, shared := range []bool{false, true} {
+		t.Run(fmt.Sprintf("shared=%v", shared), func(t *testing.T) {
+			// Two sessions of the same player, each holding what it loaded at the start
+			first := &Store{Dir: t.TempDir(), Player: "ann", mu: &sync.Mutex{}}
+			if shared {
+				first = serverPlayers(t, "ann")[0]
+			}
+			second := *first
+
+			if _, _, err := first.AddHighScore(HighScore{Score: 10}); err != nil {
+				t.Fatal(err)
+			}
+			if hs, isNew, err := second.AddHighScore(HighScore{Score: 5}); err != nil || isNew || hs.Score != 10 {
+				t.Errorf("lower score from the other session: %+v, new = %v, %v, want 10 kept", hs, isNew, err)
+			}
+
+			if _, err := first.AddCoins(5); err != nil {
+				t.Fatal(err)
+			}
+			if w, err := second.AddCoins(3); err != nil || w.Coins != 8 {
+				t.Errorf("after banking 5 and 3 coins: %+v, %v, want 8 coins", w, err)
+			}
+
+			if _, err := first.Spend("theme:Ocean", 6); err != nil {
+				t.Fatal(err)
+			}
+			if w, err := second.Spend("sprite:Crow", 6); !errors.Is(err, ErrNotEnoughCoins) || w.Coins != 2 {
+				t.Errorf("spending coins already spent: %+v, %v, want ErrNotEnoughCoins with 2 coins", w, err)
+			}
+			if w, err := second.Equip("", "Ocean"); err != nil || !w.Owns("theme:Ocean") || w.Theme != "Ocean" {
+				t.Errorf("equipping kept %+v, %v, want the Ocean theme bought in the other session", w, err)
+			}
+
+			if _, _, err := first.AddBestSplits("Speedrun 10 Normal", []time.Duration{time.Second}); err != nil {
+				t.Fatal(err)
+			}
+			splits, _, err := second.AddBestSplits("Speedrun 25 Normal", []time.Duration{time.Minute})
+			if err != nil || len(splits) != 2 {
+				t.Errorf("best splits = %v, %v, want both targets", splits, err)
+			}
+
+			if _, err := first.AddTournament(Tournament{Seed: 1}); err != nil {
+				t.Fatal(err)
+			}
+			if tournaments, err := second.AddTournament(Tournament{Seed: 2}); err != nil || len(tournaments) != 2 {
+				t.Errorf("tournaments = %+v, %v, want both", tournaments, err)
+			}
+		})
+	}
+}
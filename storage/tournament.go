@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"sort"
+	"time"
+)
+
+const tournamentsFile = "tournaments.json"
+
+// TournamentRun is one player's turn in a hot-seat tournament
+type TournamentRun struct {
+	Player   string        `json:"player"`
+	Round    int           `json:"round"` // 1-based
+	Score    int           `json:"score"`
+	Duration time.Duration `json:"duration"`
+	Cleared  bool          `json:"cleared,omitempty"` // Reached a course's finish line
+}
+
+// Tournament is a hot-seat tournament where every player takes turns on the same pipes
+type Tournament struct {
+	Date       time.Time       `json:"date"`
+	Seed       uint64          `json:"seed"`
+	Difficulty string          `json:"difficulty"`
+	Hitbox     string          `json:"hitbox"`
+	PowerUps   bool            `json:"power_ups,omitempty"`
+	Players    []string        `json:"players"`
+	Rounds     int             `json:"rounds"`
+	Runs       []TournamentRun `json:"runs"` // In the order they were played
+}
+
+// Standing is a player's place in a tournament's round-robin table
+type Standing struct {
+	Player string
+	Points int // 2 for every head-to-head win and 1 for every draw
+	Wins   int
+	Draws  int
+	Losses int
+	Best   TournamentRun // Player's best run of the tournament
+}
+
+// LoadTournaments loads the player's finished tournaments, oldest first
+func (s *Store) LoadTournaments() ([]Tournament, error) {
+	// No tournaments played yet unless the file says otherwise
+	tournaments := []Tournament{}
+	if err := s.loadPersonal(tournamentsFile, &tournaments); err != nil {
+		return nil, err
+	}
+	return tournaments, nil
+}
+
+// SaveTournaments saves the player's finished tournaments to disk
+func (s *Store) SaveTournaments(tournaments []Tournament) error {
+	return s.savePersonal(tournamentsFile, tournaments)
+}
+
+// AddTournament adds a finished tournament to the player's history on disk and returns the whole history
+func (s *Store) AddTournament(t Tournament) ([]Tournament, error) {
+	tournaments := []Tournament{}
+	if err := s.updatePersonal(tournamentsFile, &tournaments, func() error {
+		tournaments = append(tournaments, t)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return tournaments, nil
+}
+
+// beats orders runs on the same pipes: clearing a course beats crashing and the faster clear wins,
+// otherwise the higher score wins and then whoever stayed in the air longer
+func beats(a, b TournamentRun) bool {
+	if a.Cleared != b.Cleared {
+		return a.Cleared
+	}
+	if a.Cleared {
+		return a.Duration < b.Duration
+	}
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.Duration > b.Duration
+}
+
+// Run returns a player's run in a round, nil if it hasn't been played
+func (t *Tournament) Run(player string, round int) *TournamentRun {
+	for i, r := range t.Runs {
+		if r.Player == player && r.Round == round {
+			return &t.Runs[i]
+		}
+	}
+	return nil
+}
+
+// HeadToHead compares two players round by round, counting only rounds both have played
+func (t *Tournament) HeadToHead(player, opponent string) (wins, draws, losses int) {
+	for round := 1; round <= t.Rounds; round++ {
+		a, b := t.Run(player, round), t.Run(opponent, round)
+		switch {
+		case a == nil || b == nil:
+		case beats(*a, *b):
+			wins++
+		case beats(*b, *a):
+			losses++
+		default:
+			draws++
+		}
+	}
+	return wins, draws, losses
+}
+
+// Standings returns the round-robin table, best first
+// Ties on points go to the player with the better best run
+func (t *Tournament) Standings() []Standing {
+	standings := make([]Standing, 0, len(t.Players))
+	for _, player := range t.Players {
+		s := Standing{Player: player}
+		for _, opponent := range t.Players {
+			if opponent == player {
+				continue
+			}
+			wins, draws, losses := t.HeadToHead(player, opponent)
+			s.Wins += wins
+			s.Draws += draws
+			s.Losses += losses
+		}
+		s.Points = 2*s.Wins + s.Draws
+
+		for _, r := range t.Runs {
+			if r.Player == player && (s.Best.Player == "" || beats(r, s.Best)) {
+				s.Best = r
+			}
+		}
+		standings = append(standings, s)
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+		return beats(standings[i].Best, standings[j].Best)
+	})
+	return standings
+}
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBeats(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b TournamentRun
+		want bool
+	}{
+		{name: "clearing beats crashing", a: TournamentRun{Cleared: true, Score: 5}, b: TournamentRun{Score: 9}, want: true},
+		{name: "faster clear wins", a: TournamentRun{Cleared: true, Duration: time.Minute}, b: TournamentRun{Cleared: true, Duration: 2 * time.Minute}, want: true},
+		{name: "slower clear loses", a: TournamentRun{Cleared: true, Duration: 2 * time.Minute}, b: TournamentRun{Cleared: true, Duration: time.Minute}, want: false},
+		{name: "higher score wins", a: TournamentRun{Score: 9, Duration: time.Second}, b: TournamentRun{Score: 5, Duration: time.Minute}, want: true},
+		{name: "longer flight breaks a tied score", a: TournamentRun{Score: 5, Duration: time.Minute}, b: TournamentRun{Score: 5, Duration: time.Second}, want: true},
+		{name: "identical runs draw", a: TournamentRun{Score: 5, Duration: time.Minute}, b: TournamentRun{Score: 5, Duration: time.Minute}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := beats(tt.a, tt.b); got != tt.want {
+				t.Errorf("beats = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeadToHead(t *testing.T) {
+	tour := &Tournament{
+		Players: []string{"ann", "bob"},
+		Rounds:  4,
+		Runs: []TournamentRun{
+			{Player: "ann", Round: 1, Score: 5},
+			{Player: "bob", Round: 1, Score: 3},
+			{Player: "ann", Round: 2, Score: 2},
+			{Player: "bob", Round: 2, Score: 4},
+			{Player: "ann", Round: 3, Score: 4, Duration: time.Second},
+			{Player: "bob", Round: 3, Score: 4, Duration: time.Second},
+			{Player: "ann", Round: 4, Score: 9}, // Bob hasn't played round 4 yet
+		},
+	}
+
+	wins, draws, losses := tour.HeadToHead("ann", "bob")
+	if wins != 1 || draws != 1 || losses != 1 {
+		t.Errorf("ann against bob: %d-%d-%d, want 1-1-1", wins, draws, losses)
+	}
+}
+
+func TestStandings(t *testing.T) {
+	// runs builds one round's runs from each player's score
+	runs := func(round int, scores map[string]int) []TournamentRun {
+		var rs []TournamentRun
+		for _, player := range []string{"ann", "bob", "cat"} {
+			if score, ok := scores[player]; ok {
+				rs = append(rs, TournamentRun{Player: player, Round: round, Score: score, Duration: time.Duration(score) * time.Second})
+			}
+		}
+		return rs
+	}
+
+	tests := []struct {
+		name       string
+		rounds     [][]TournamentRun
+		wantOrder  []string
+		wantPoints []int
+	}{
+		{
+			name:       "wins and draws",
+			rounds:     [][]TournamentRun{runs(1, map[string]int{"ann": 3, "bob": 7, "cat": 3})},
+			wantOrder:  []string{"bob", "ann", "cat"},
+			wantPoints: []int{4, 1, 1},
+		},
+		{
+			name: "tie on points goes to the best run",
+			rounds: [][]TournamentRun{
+				runs(1, map[string]int{"ann": 5, "bob": 3, "cat": 1}),
+				runs(2, map[string]int{"ann": 2, "bob": 8, "cat": 4}),
+				runs(3, map[string]int{"ann": 3, "bob": 2, "cat": 6}),
+			},
+			wantOrder:  []string{"bob", "cat", "ann"},
+			wantPoints: []int{6, 6, 6},
+		},
+		{
+			name: "a clear beats any score",
+			rounds: [][]TournamentRun{
+				runs(1, map[string]int{"ann": 20, "bob": 9}),
+				{{Player: "cat", Round: 1, Score: 4, Duration: time.Minute, Cleared: true}},
+			},
+			wantOrder:  []string{"cat", "ann", "bob"},
+			wantPoints: []int{4, 2, 0},
+		},
+		{
+			name:       "unplayed rounds don't count",
+			rounds:     [][]TournamentRun{runs(1, map[string]int{"ann": 1, "bob": 2})},
+			wantOrder:  []string{"bob", "ann", "cat"},
+			wantPoints: []int{2, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tour := &Tournament{Players: []string{"ann", "bob", "cat"}, Rounds: 3}
+			for _, round := range tt.rounds {
+				tour.Runs = append(tour.Runs, round...)
+			}
+
+			standings := tour.Standings()
+			if len(standings) != len(tt.wantOrder) {
+				t.Fatalf("got %d standings, want %d", len(standings), len(tt.wantOrder))
+			}
+			for i, s := range standings {
+				if s.Player != tt.wantOrder[i] || s.Points != tt.wantPoints[i] {
+					t.Errorf("place %d: %s with %d points, want %s with %d", i+1, s.Player, s.Points, tt.wantOrder[i], tt.wantPoints[i])
+				}
+				if s.Points != 2*s.Wins+s.Draws {
+					t.Errorf("%s: %d points from %d wins and %d draws", s.Player, s.Points, s.Wins, s.Draws)
+				}
+			}
+		})
+	}
+}
+
+func TestStandingsBestRun(t *testing.T) {
+	tour := &Tournament{
+		Players: []string{"ann"},
+		Rounds:  3,
+		Runs: []TournamentRun{
+			{Player: "ann", Round: 1, Score: 5},
+			{Player: "ann", Round: 2, Score: 2, Cleared: true, Duration: 3 * time.Minute},
+			{Player: "ann", Round: 3, Score: 2, Cleared: true, Duration: 2 * time.Minute},
+		},
+	}
+
+	if best := tour.Standings()[0].Best; best.Round != 3 {
+		t.Errorf("best run is round %d, want the faster clear of round 3", best.Round)
+	}
+}
+
+func TestTournamentsRoundTrip(t *testing.T) {
+	s := &Store{Dir: t.TempDir(), Player: "tester"}
+	if got, err := s.LoadTournaments(); err != nil || len(got) != 0 {
+		t.Fatalf("fresh store: %d tournaments, %v", len(got), err)
+	}
+
+	want := Tournament{Seed: 9, Players: []string{"ann", "bob"}, Rounds: 1, Runs: []TournamentRun{{Player: "ann", Round: 1, Score: 3}}}
+	if err := s.SaveTournaments([]Tournament{want}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.LoadTournaments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Seed != want.Seed || len(got[0].Runs) != 1 || got[0].Runs[0] != want.Runs[0] {
+		t.Errorf("loaded %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"errors"
+	"slices"
+)
+
+const walletFile = "wallet.json"
+
+// ErrNotEnoughCoins is returned when the balance doesn't cover a purchase
+var ErrNotEnoughCoins = errors.New("not enough coins")
+
+// Wallet holds the coin balance and the cosmetics bought with it
+type Wallet struct {
+	Coins    int      `json:"coins"`
+	Unlocked []string `json:"unlocked,omitempty"` // Shop items bought, e.g. "sprite:Crow"
+	Sprite   string   `json:"sprite,omitempty"`   // Equipped bird sprite, empty for the default
+	Theme    string   `json:"theme,omitempty"`    // Equipped theme, empty for the default
+}
+
+// LoadWallet loads the player's coin balance and unlocks
+func (s *Store) LoadWallet() (*Wallet, error) {
+	// No coins collected yet unless the file says otherwise
+	w := &Wallet{}
+	if err := s.loadPersonal(walletFile, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// SaveWallet saves the player's coin balance and unlocks to disk
+func (s *Store) SaveWallet(w *Wallet) error {
+	return s.savePersonal(walletFile, w)
+}
+
+// AddCoins adds coins to the player's balance on disk and returns the updated wallet
+func (s *Store) AddCoins(coins int) (*Wallet, error) {
+	w := &Wallet{}
+	if err := s.updatePersonal(walletFile, w, func() error {
+		w.Coins += coins
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Spend pays for a shop item out of the player's balance on disk and unlocks it
+// Returns the wallet as it is on disk along with ErrNotEnoughCoins if the balance is too low
+func (s *Store) Spend(id string, price int) (*Wallet, error) {
+	w := &Wallet{}
+	err := s.updatePersonal(walletFile, w, func() error {
+		if w.Owns(id) {
+			return nil // Bought in another session already
+		}
+		if w.Coins < price {
+			return ErrNotEnoughCoins
+		}
+		w.Coins -= price
+		w.Unlocked = append(w.Unlocked, id)
+		return nil
+	})
+	if errors.Is(err, ErrNotEnoughCoins) {
+		return w, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Equip remembers the player's equipped sprite and theme on disk and returns the updated wallet
+func (s *Store) Equip(sprite, theme string) (*Wallet, error) {
+	w := &Wallet{}
+	if err := s.updatePersonal(walletFile, w, func() error {
+		w.Sprite = sprite
+		w.Theme = theme
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Owns checks if a shop item has been bought
+func (w *Wallet) Owns(id string) bool {
+	return slices.Contains(w.Unlocked, id)
+}
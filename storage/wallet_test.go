@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestWalletRoundTrip(t *testing.T) {
+	s := &Store{Dir: t.TempDir(), Player: "tester"}
+
+	w, err := s.LoadWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Coins != 0 || len(w.Unlocked) != 0 {
+		t.Errorf("new wallet = %+v, want it empty", w)
+	}
+
+	saved := &Wallet{Coins: 12, Unlocked: []string{"sprite:Crow", "theme:Ocean"}, Sprite: "Crow", Theme: "Ocean"}
+	if err := s.SaveWallet(saved); err != nil {
+		t.Fatal(err)
+	}
+	w, err = s.LoadWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Coins != saved.Coins || !slices.Equal(w.Unlocked, saved.Unlocked) || w.Sprite != saved.Sprite || w.Theme != saved.Theme {
+		t.Errorf("loaded wallet = %+v, want %+v", w, saved)
+	}
+}
+
+func TestSpend(t *testing.T) {
+	tests := []struct {
+		name         string
+		wallet       Wallet
+		id           string
+		price        int
+		wantErr      error
+		wantCoins    int
+		wantUnlocked []string
+	}{
+		{name: "buying", wallet: Wallet{Coins: 30}, id: "theme:Ocean", price: 20, wantCoins: 10, wantUnlocked: []string{"theme:Ocean"}},
+		{name: "spending every coin", wallet: Wallet{Coins: 20}, id: "theme:Ocean", price: 20, wantCoins: 0, wantUnlocked: []string{"theme:Ocean"}},
+		{name: "not enough coins", wallet: Wallet{Coins: 19}, id: "theme:Ocean", price: 20, wantErr: ErrNotEnoughCoins, wantCoins: 19},
+		{name: "already bought", wallet: Wallet{Coins: 30, Unlocked: []string{"theme:Ocean"}}, id: "theme:Ocean", price: 20, wantCoins: 30, wantUnlocked: []string{"theme:Ocean"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Store{Dir: t.TempDir(), Player: "tester"}
+			if err := s.SaveWallet(&tt.wallet); err != nil {
+				t.Fatal(err)
+			}
+
+			w, err := s.Spend(tt.id, tt.price)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Spend() error = %v, want %v", err, tt.wantErr)
+			}
+			if w.Coins != tt.wantCoins || !slices.Equal(w.Unlocked, tt.wantUnlocked) {
+				t.Errorf("wallet = %+v, want %d coins and %v unlocked", w, tt.wantCoins, tt.wantUnlocked)
+			}
+
+			// What was returned is what was saved
+			loaded, err := s.LoadWallet()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loaded.Coins != w.Coins || !slices.Equal(loaded.Unlocked, w.Unlocked) {
+				t.Errorf("saved wallet = %+v, want %+v", loaded, w)
+			}
+		})
+	}
+}
+
+func TestAddCoinsKeepsUnlocks(t *testing.T) {
+	s := &Store{Dir: t.TempDir(), Player: "tester"}
+	if err := s.SaveWallet(&Wallet{Coins: 5, Unlocked: []string{"sprite:Crow"}, Sprite: "Crow"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.AddCoins(7); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Equip("Crow", "Sunset"); err != nil {
+		t.Fatal(err)
+	}
+	w, err := s.LoadWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Coins != 12 || !w.Owns("sprite:Crow") || w.Sprite != "Crow" || w.Theme != "Sunset" {
+		t.Errorf("wallet = %+v, want 12 coins, the Crow unlocked and equipped with Sunset", w)
+	}
+}
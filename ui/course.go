@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/game"
+)
+
+const courseClearPadding = 12 // Vertical padding for course clear screen ASCII art
+
+// courseName returns the name of a course, or a placeholder for unnamed courses
+func courseName(c *domain.Course) string {
+	if c.Name == "" {
+		return "Untitled"
+	}
+	return c.Name
+}
+
+// renderCourseClear shows the result of a course played to its finish line
+func renderCourseClear(m game.Model) string {
+	// Get theme styles
+	_, _, _, newRecordStyle := getStyles(m)
+
+	var b strings.Builder
+
+	// ASCII Art for CLEAR
+	asciiArt := ` ██████╗██╗     ███████╗ █████╗ ██████╗
+██╔════╝██║     ██╔════╝██╔══██╗██╔══██╗
+██║     ██║     █████╗  ███████║██████╔╝
+██║     ██║     ██╔══╝  ██╔══██║██╔══██╗
+╚██████╗███████╗███████╗██║  ██║██║  ██║
+ ╚═════╝╚══════╝╚══════╝╚═╝  ╚═╝╚═╝  ╚═╝`
+
+	padding := (m.Height - courseClearPadding) / 2
+	if padding < 0 {
+		padding = 0
+	}
+	for i := 0; i < padding; i++ {
+		b.WriteString("\n")
+	}
+
+	// Display ASCII art
+	b.WriteString(centerText(newRecordStyle.Render(asciiArt), m.Width))
+	b.WriteString("\n\n")
+
+	// Display course name
+	course := fmt.Sprintf("Course: %s  |  %d pipes", courseName(m.World.Course), len(m.World.Course.Pipes))
+	b.WriteString(centerText(course, m.Width))
+	b.WriteString("\n")
+
+	// Display time and statistics
+	minutes, seconds, milliseconds := formatDuration(m.World.Elapsed())
+	result := fmt.Sprintf("Time: %02d:%02d.%03d  |  Jumps: %d  |  Crashes: %d",
+		minutes, seconds, milliseconds, m.Stats.JumpCount, m.World.Crashes)
+	b.WriteString(centerText(result, m.Width))
+	b.WriteString("\n\n")
+
+	instructions := "Press SPACE or R to play again  |  ESC for title  |  Press Q to quit"
+	if m.Editor != nil {
+		instructions = "Press SPACE or R to play-test again  |  ESC for editor  |  Press Q to quit"
+	}
+	b.WriteString(centerText(instructions, m.Width))
+
+	return b.String()
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/takish/flappy-bird-tui/game"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+const dailyBoardPadding = 6 // Vertical padding for the daily results screen
+
+// renderDailyResults lists up to limit results of a daily board
+// Only a shared store has other players' runs to rank against; elsewhere the board holds this player's own run
+func renderDailyResults(board []storage.DailyResult, limit int, ranked bool, width int) string {
+	var b strings.Builder
+
+	if len(board) < limit {
+		limit = len(board)
+	}
+	for i := 0; i < limit; i++ {
+		r := board[i]
+		minutes, seconds, milliseconds := formatDuration(r.Duration)
+		text := fmt.Sprintf("%s  %d pts  %02d:%02d.%03d",
+			r.Player, r.Score, minutes, seconds, milliseconds)
+		if r.Abandoned {
+			text = r.Player + "  did not finish"
+		}
+		if ranked {
+			text = fmt.Sprintf("%d. %s", i+1, text)
+		}
+		b.WriteString(centerText(text, width))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// renderDailyBoard shows one day's daily challenge results
+func renderDailyBoard(m game.Model) string {
+	titleStyle, scoreStyle, _, _ := getStyles(m)
+
+	var b strings.Builder
+
+	board := m.DailyBoards[m.BoardDate]
+	padding := (m.Height - dailyBoardPadding - len(board)) / 2
+	if padding < 0 {
+		padding = 0
+	}
+	for i := 0; i < padding; i++ {
+		b.WriteString("\n")
+	}
+
+	title := "=== DAILY RESULTS ==="
+	if m.Store.Shared() {
+		title = "=== DAILY LEADERBOARD ==="
+	}
+	b.WriteString(centerText(titleStyle.Render(title), m.Width))
+	b.WriteString("\n")
+	b.WriteString(centerText(scoreStyle.Render("◀  "+m.BoardDate+"  ▶"), m.Width))
+	b.WriteString("\n\n")
+
+	b.WriteString(renderDailyResults(board, len(board), m.Store.Shared(), m.Width))
+	b.WriteString("\n")
+
+	instructions := "←/→ to change day  |  ESC to go back  |  Q to quit"
+	b.WriteString(centerText(instructions, m.Width))
+
+	return b.String()
+}
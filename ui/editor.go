@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/game"
+)
+
+const (
+	editorStatusLines = 5   // Lines below the canvas in the editor
+	selectedChar      = "▲" // Marks the selected pipe under the canvas
+	unreachableChar   = "✗" // Marks a pipe the bird can't get through
+)
+
+// renderEditor shows the course editor
+func renderEditor(m game.Model) string {
+	_, scoreStyle, gameOverStyle, _ := getStyles(m)
+	e := m.Editor
+	c := e.Course
+
+	// Draw the course with the same glyphs as a run, scrolled to the selection
+	height := min(c.Height, m.Height-editorStatusLines)
+	canvas := newCanvas(m.Width, max(height, 0))
+	screenX := func(pos int) int {
+		return domain.BirdStartX + pos - e.Scroll
+	}
+	for i, p := range c.Pipes {
+		drawPipe(canvas, &domain.Pipe{X: screenX(c.Position(i)), Width: p.Width, GapY: p.GapY, GapSize: p.GapSize})
+	}
+	drawFinish(canvas, screenX(c.Length()))
+
+	// Bird at its start position
+	birdX, birdY := screenX(0), c.Height/2
+	if birdY < len(canvas) && birdX >= 0 && birdX+1 < m.Width {
+		sprite := []rune(m.Sprite.Down)
+		canvas[birdY][birdX] = sprite[0]
+		canvas[birdY][birdX+1] = sprite[1]
+	}
+
+	var b strings.Builder
+	for _, row := range canvas {
+		b.WriteString(string(row))
+		b.WriteString("\n")
+	}
+
+	// Mark the selection and the first unreachable pipe under the canvas
+	ruler := []rune(strings.Repeat(" ", m.Width))
+	mark := func(i int, char string) {
+		x, width := screenX(c.Position(i)), 1
+		if i < len(c.Pipes) {
+			width = c.Pipes[i].Width
+		}
+		for col := max(x, 0); col < x+width && col < len(ruler); col++ {
+			ruler[col] = []rune(char)[0]
+		}
+	}
+	if !e.Checking && e.Unreachable >= 0 {
+		mark(e.Unreachable, unreachableChar)
+	}
+	mark(e.Cursor, selectedChar)
+	b.WriteString(scoreStyle.Render(string(ruler)))
+	b.WriteString("\n")
+
+	// Describe the selection
+	var info string
+	if e.Cursor == len(c.Pipes) {
+		info = fmt.Sprintf("Finish line  Spacing: %d", c.End)
+	} else {
+		p := c.Pipes[e.Cursor]
+		info = fmt.Sprintf("Pipe %d/%d  Spacing: %d  Gap: %d-%d (%d)  Width: %d",
+			e.Cursor+1, len(c.Pipes), p.Spacing, p.GapY, p.GapY+p.GapSize, p.GapSize, p.Width)
+	}
+	b.WriteString(scoreStyle.Render(info))
+	b.WriteString("  ")
+
+	// Show whether the bird can get through every gap
+	switch {
+	case e.Checking:
+		b.WriteString(scoreStyle.Render("Checking gaps..."))
+	case e.Unreachable >= 0:
+		b.WriteString(gameOverStyle.Render(fmt.Sprintf("Pipe %d can't be reached on %s", e.Unreachable+1, m.Difficulty)))
+	default:
+		b.WriteString(scoreStyle.Render(fmt.Sprintf("All gaps reachable on %s", m.Difficulty)))
+	}
+	b.WriteString("\n")
+
+	// File name, unsaved marker and the result of the last action
+	file := e.Path
+	if e.Modified {
+		file += " *"
+	}
+	if e.Message != "" {
+		file += "  " + e.Message
+	}
+	b.WriteString(file)
+	b.WriteString("\n")
+
+	b.WriteString("←/→ select  ↑/↓ move gap  +/- gap size  </> move pipe  [/] width\n")
+	b.WriteString("A add  X delete  1-3 difficulty  P play-test  S save  Q quit")
+
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/takish/flappy-bird-tui/domain"
+)
+
+// itemChars are the glyphs of power-up items waiting in pipe gaps
+var itemChars = map[domain.PowerUpKind]rune{
+	domain.PowerShield: '◆',
+	domain.PowerSlowMo: '◷',
+	domain.PowerShrink: '•',
+}
+
+// drawItems draws the power-up items that are in view onto the canvas
+func drawItems(canvas [][]rune, pipes []*domain.Pipe) {
+	for _, p := range pipes {
+		if p.Item == nil {
+			continue
+		}
+		x, y := p.ItemPos()
+		if y >= 0 && y < len(canvas) && x >= 0 && x < len(canvas[y]) {
+			canvas[y][x] = itemChars[p.Item.Kind]
+		}
+	}
+}
+
+// renderPowerUp describes the active power-up and how long it lasts
+func renderPowerUp(w *domain.World) string {
+	switch {
+	case w.Shielding:
+		return "Shield: hit!"
+	case w.Power == nil:
+		return ""
+	case w.Power.Steps == 0:
+		// Shields last until they are hit
+		return string(itemChars[w.Power.Kind]) + " " + w.Power.Kind.String()
+	default:
+		return fmt.Sprintf("%c %s %.1fs", itemChars[w.Power.Kind], w.Power.Kind, w.Power.TimeLeft().Seconds())
+	}
+}
+
+// powerUpsText returns the title screen line for the power-ups setting
+func powerUpsText(on bool) string {
+	if on {
+		return "Power-ups: On, ranked separately [U]"
+	}
+	return "Power-ups: Off [U]"
+}
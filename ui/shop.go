@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/game"
+)
+
+const (
+	coinChar    = '¤' // Coin waiting to be collected
+	shopPadding = 8   // Vertical padding for the shop screen
+)
+
+// drawCoins draws the coins that are in view onto the canvas
+func drawCoins(canvas [][]rune, coins []domain.Coin) {
+	for _, c := range coins {
+		if c.Y >= 0 && c.Y < len(canvas) && c.X >= 0 && c.X < len(canvas[c.Y]) && canvas[c.Y][c.X] == ' ' {
+			canvas[c.Y][c.X] = coinChar
+		}
+	}
+}
+
+// renderPreview shows what a shop item looks like
+func renderPreview(item game.ShopItem) string {
+	if item.Sprite != nil {
+		return item.Sprite.Down + " "
+	}
+	colors := item.Theme.GetColors()
+	swatch := func(c lipgloss.Color) string {
+		return lipgloss.NewStyle().Foreground(c).Render("■")
+	}
+	return swatch(colors.Title) + swatch(colors.Score) + swatch(colors.NewRecord)
+}
+
+// renderShop lists the cosmetics coins can unlock
+func renderShop(m game.Model) string {
+	titleStyle, scoreStyle, _, newRecordStyle := getStyles(m)
+	items := game.ShopItems()
+
+	var b strings.Builder
+	padding := (m.Height - shopPadding - len(items)) / 2
+	for i := 0; i < padding; i++ {
+		b.WriteString("\n")
+	}
+
+	b.WriteString(centerText(titleStyle.Render("=== SHOP ==="), m.Width))
+	b.WriteString("\n")
+	b.WriteString(centerText(newRecordStyle.Render(fmt.Sprintf("%c %d coins", coinChar, m.Wallet.Coins)), m.Width))
+	b.WriteString("\n\n")
+
+	for i, item := range items {
+		// Start each section with its heading
+		if i == 0 || (item.Sprite == nil) != (items[i-1].Sprite == nil) {
+			heading := "Birds"
+			if item.Sprite == nil {
+				heading = "Themes"
+			}
+			b.WriteString(centerText(scoreStyle.Render(heading), m.Width))
+			b.WriteString("\n")
+		}
+
+		status := fmt.Sprintf("%c %d", coinChar, item.Price)
+		switch {
+		case m.Equipped(item):
+			status = "Equipped"
+		case m.Owns(item):
+			status = "Owned"
+		}
+
+		cursor := "  "
+		if i == m.ShopCursor {
+			cursor = "▶ "
+		}
+		line := cursor + renderPreview(item) + "  " + fmt.Sprintf("%-8s %-8s", item.Name, status)
+		if i == m.ShopCursor {
+			line = scoreStyle.Render(cursor) + renderPreview(item) + "  " + scoreStyle.Render(fmt.Sprintf("%-8s %-8s", item.Name, status))
+		}
+		b.WriteString(centerText(line, m.Width))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if m.Notice != "" {
+		b.WriteString(centerText(m.Notice, m.Width))
+		b.WriteString("\n")
+	}
+
+	instructions := "↑/↓ to choose  |  SPACE to buy or equip  |  ESC to go back  |  Q to quit"
+	b.WriteString(centerText(instructions, m.Width))
+
+	return b.String()
+}
@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/game"
+)
+
+// formatDelta formats a split delta as +S.mmm or -S.mmm
+func formatDelta(d time.Duration) string {
+	sign := "+"
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	return fmt.Sprintf("%s%d.%03d", sign, int(d.Seconds()), int(d.Milliseconds())%1000)
+}
+
+// splitPipes returns the pipe count at which split i is taken
+func splitPipes(i int, target int) int {
+	return min((i+1)*domain.SplitInterval, target)
+}
+
+// renderLastSplit describes the most recent split of a speedrun and its delta against the best
+func renderLastSplit(m game.Model) string {
+	splits := m.World.Splits
+	if len(splits) == 0 {
+		return ""
+	}
+
+	i := len(splits) - 1
+	minutes, seconds, milliseconds := formatDuration(splits[i])
+	text := fmt.Sprintf("Split %d: %02d:%02d.%03d", splitPipes(i, m.World.Target), minutes, seconds, milliseconds)
+	if delta, ok := m.SplitDelta(i); ok {
+		text += fmt.Sprintf(" (%s)", formatDelta(delta))
+	}
+	return text
+}
@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/takish/flappy-bird-tui/game"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+const (
+	tournamentPadding = 12 // Vertical padding for the tournament screens
+	tableNameWidth    = 6  // Characters of a name shown in a table column
+	pastTournaments   = 3  // Past tournaments listed on the setup screen
+)
+
+// renderTournamentSetup shows the players entered so far and the tournament's settings
+func renderTournamentSetup(m game.Model) string {
+	titleStyle, scoreStyle, _, _ := getStyles(m)
+	t := m.Tournament
+
+	var b strings.Builder
+
+	padding := (m.Height - tournamentPadding - game.MaxTournamentPlayers) / 2
+	if padding < 0 {
+		padding = 0
+	}
+	for i := 0; i < padding; i++ {
+		b.WriteString("\n")
+	}
+
+	b.WriteString(centerText(titleStyle.Render("=== TOURNAMENT ==="), m.Width))
+	b.WriteString("\n\n")
+
+	// List the players, followed by the name being typed
+	lines := []string{fmt.Sprintf("Players (%d/%d):", len(t.Players), game.MaxTournamentPlayers)}
+	for i, p := range t.Players {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, p))
+	}
+	if len(t.Players) < game.MaxTournamentPlayers {
+		lines = append(lines, fmt.Sprintf("%d. %s_", len(t.Players)+1, t.Entry))
+	}
+	b.WriteString(centerBlock(lines, m.Width))
+	b.WriteString("\n")
+
+	// Every turn is played with the settings chosen on the title screen
+	powerUps := "Off"
+	if m.PowerUps {
+		powerUps = "On"
+	}
+	settings := fmt.Sprintf("Rounds: ◀ %d ▶  |  %s  |  Hitbox: %s  |  Power-ups: %s",
+		t.Rounds, m.Difficulty, m.Hitbox, powerUps)
+	b.WriteString(centerText(scoreStyle.Render(settings), m.Width))
+	b.WriteString("\n\n")
+
+	if m.Notice != "" {
+		b.WriteString(centerText(m.Notice, m.Width))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(centerText("Type a name and press ENTER to add  |  BACKSPACE to remove", m.Width))
+	b.WriteString("\n")
+	b.WriteString(centerText("ENTER with no name to start  |  ←/→ rounds  |  ESC for title", m.Width))
+
+	// Winners of the most recent tournaments
+	if len(m.Tournaments) > 0 {
+		b.WriteString("\n\n")
+		b.WriteString(centerText("Past tournaments", m.Width))
+		for i := len(m.Tournaments) - 1; i >= max(len(m.Tournaments)-pastTournaments, 0); i-- {
+			past := m.Tournaments[i]
+			winner := past.Standings()[0].Player
+			text := fmt.Sprintf("%s  %s won  (%d players, %d rounds)",
+				past.Date.Format("2006-01-02"), winner, len(past.Players), past.Rounds)
+			b.WriteString("\n")
+			b.WriteString(centerText(text, m.Width))
+		}
+	}
+
+	return b.String()
+}
+
+// renderTournament shows the round-robin table between turns and the final standings at the end
+func renderTournament(m game.Model) string {
+	titleStyle, scoreStyle, _, newRecordStyle := getStyles(m)
+	t := m.Tournament
+
+	var b strings.Builder
+
+	padding := (m.Height - tournamentPadding - len(t.Players)) / 2
+	if padding < 0 {
+		padding = 0
+	}
+	for i := 0; i < padding; i++ {
+		b.WriteString("\n")
+	}
+
+	title := fmt.Sprintf("=== TOURNAMENT  Round %d/%d ===", t.Round(), t.Rounds)
+	if t.Done() {
+		title = "=== FINAL STANDINGS ==="
+	}
+	b.WriteString(centerText(titleStyle.Render(title), m.Width))
+	b.WriteString("\n\n")
+
+	// Result of the turn that just ended
+	if len(t.Runs) > 0 {
+		last := t.Runs[len(t.Runs)-1]
+		b.WriteString(centerText(scoreStyle.Render(fmt.Sprintf("%s: %s", last.Player, runText(last))), m.Width))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(centerBlock(tournamentTable(&t.Tournament), m.Width))
+	b.WriteString("\n")
+
+	if t.Done() {
+		winner := newRecordStyle.Render(fmt.Sprintf("★ %s wins the tournament! ★", t.Standings()[0].Player))
+		b.WriteString(centerText(winner, m.Width))
+		b.WriteString("\n\n")
+		b.WriteString(centerText("Press SPACE for title  |  Press Q to quit", m.Width))
+		return b.String()
+	}
+
+	next := fmt.Sprintf("Next up: %s  |  Seed: %d  |  %s", t.NextPlayer(), t.Seed, t.Difficulty)
+	b.WriteString(centerText(next, m.Width))
+	b.WriteString("\n\n")
+	b.WriteString(centerText("Press SPACE when ready  |  ESC to abandon  |  Q to quit", m.Width))
+
+	return b.String()
+}
+
+// tournamentTable lays out the round-robin table, best first
+// Each cell holds the row player's head-to-head record against the column player
+func tournamentTable(t *storage.Tournament) []string {
+	standings := t.Standings()
+
+	header := fmt.Sprintf("%-*s", tableNameWidth+4, "")
+	for _, s := range standings {
+		header += fmt.Sprintf(" %-*s", tableNameWidth, shortName(s.Player))
+	}
+	header += "  Pts  Best"
+	lines := []string{header}
+
+	for i, s := range standings {
+		line := fmt.Sprintf("%d. %-*s ", i+1, tableNameWidth, shortName(s.Player))
+		for _, opponent := range standings {
+			cell := "-"
+			if opponent.Player != s.Player {
+				wins, draws, losses := t.HeadToHead(s.Player, opponent.Player)
+				cell = fmt.Sprintf("%d-%d", wins, losses)
+				if draws > 0 {
+					cell = fmt.Sprintf("%d-%d-%d", wins, draws, losses)
+				}
+			}
+			line += fmt.Sprintf(" %-*s", tableNameWidth, cell)
+		}
+		best := "-"
+		if s.Best.Cleared {
+			best = fmt.Sprintf("%.1fs", s.Best.Duration.Seconds())
+		} else if s.Best.Player != "" {
+			best = fmt.Sprint(s.Best.Score)
+		}
+		line += fmt.Sprintf("  %3d  %s", s.Points, best)
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// runText describes a tournament run
+func runText(r storage.TournamentRun) string {
+	minutes, seconds, milliseconds := formatDuration(r.Duration)
+	if r.Cleared {
+		return fmt.Sprintf("cleared in %02d:%02d.%03d", minutes, seconds, milliseconds)
+	}
+	return fmt.Sprintf("%d pts %02d:%02d.%03d", r.Score, minutes, seconds, milliseconds)
+}
+
+// shortName cuts a name down to fit a table column
+func shortName(name string) string {
+	runes := []rune(name)
+	if len(runes) > tableNameWidth {
+		return string(runes[:tableNameWidth])
+	}
+	return name
+}
+
+// centerBlock centers lines as one left-aligned block
+func centerBlock(lines []string, width int) string {
+	blockWidth := 0
+	for _, line := range lines {
+		blockWidth = max(blockWidth, len([]rune(line)))
+	}
+	var b strings.Builder
+	for _, line := range lines {
+		padded := line + strings.Repeat(" ", blockWidth-len([]rune(line)))
+		b.WriteString(centerText(padded, width))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/takish/flappy-bird-tui/game"
+)
+
+const (
+	versusSeparator = "│" // Drawn between the players' playfields
+	versusPadding   = 8   // Vertical padding for the versus result screen
+)
+
+// versusKeysText describes which key each player jumps with
+func versusKeysText(m game.Model) string {
+	return fmt.Sprintf("P1 jumps with SPACE  |  P2 jumps with %s (--p2-key)", strings.ToUpper(game.KeyName(m.P2Key)))
+}
+
+// renderVersus shows every player's playfield side by side, each with its own status line
+func renderVersus(m game.Model) string {
+	_, scoreStyle, gameOverStyle, _ := getStyles(m)
+
+	var fields [][]string
+	for _, p := range m.Players {
+		// Clip each playfield to the terminal if it shrank during the match
+		world := p.World
+		width := min(game.VersusWidth(m.Width), world.Width)
+		height := min(m.Height-1, world.Height)
+		canvas := newCanvas(width, max(height, 0))
+		drawWorld(canvas, m, world)
+
+		rows := make([]string, 0, len(canvas)+1)
+		for _, row := range canvas {
+			rows = append(rows, string(row))
+		}
+
+		// Crashed players keep their final score on show
+		minutes, seconds, milliseconds := formatDuration(world.Elapsed())
+		name := p.Name
+		if p.Key != "" {
+			name += " [" + game.KeyName(p.Key) + "]"
+		}
+		status := fmt.Sprintf("%s  Score: %d  %02d:%02d.%03d", name, world.Score, minutes, seconds, milliseconds)
+		style := scoreStyle
+		if m.Net != nil && m.Net.Waiting && p.Key == "" {
+			status = fmt.Sprintf("Waiting for %s...", p.Name)
+		}
+		if world.Over {
+			status = fmt.Sprintf("%s OUT  Score: %d  %02d:%02d.%03d", p.Name, world.Score, minutes, seconds, milliseconds)
+			style = gameOverStyle
+		}
+		rows = append(rows, style.Width(width).MaxWidth(width).Render(status))
+		fields = append(fields, rows)
+	}
+
+	// Lay the playfields out row by row
+	var b strings.Builder
+	for y := range fields[0] {
+		if y > 0 {
+			b.WriteString("\n")
+		}
+		for i, rows := range fields {
+			if i > 0 {
+				b.WriteString(versusSeparator)
+			}
+			if y < len(rows) {
+				b.WriteString(rows[y])
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// renderVersusResult shows who won a versus match and how every player did
+func renderVersusResult(m game.Model) string {
+	// Get theme styles
+	titleStyle, _, gameOverStyle, newRecordStyle := getStyles(m)
+
+	var b strings.Builder
+
+	padding := (m.Height - versusPadding) / 2
+	if padding < 0 {
+		padding = 0
+	}
+	for i := 0; i < padding; i++ {
+		b.WriteString("\n")
+	}
+
+	// Display the winner, or why a network match was cut short
+	headline := titleStyle.Render("DRAW!")
+	if m.Net != nil && m.Net.Err != nil {
+		headline = gameOverStyle.Render("MATCH ABANDONED")
+	} else if winner := m.Winner(); winner != nil {
+		headline = newRecordStyle.Render(fmt.Sprintf("★ %s WINS! ★", winner.Name))
+	}
+	b.WriteString(centerText(headline, m.Width))
+	b.WriteString("\n")
+	if m.Net != nil && m.Net.Err != nil {
+		b.WriteString(centerText(m.Net.Err.Error(), m.Width))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	// Display how long every player lasted
+	for _, p := range m.Players {
+		minutes, seconds, milliseconds := formatDuration(p.World.Elapsed())
+		label := "Survived"
+		if p.World.Cleared {
+			label = "Cleared in"
+		}
+		result := fmt.Sprintf("%s  |  Score: %d  |  %s: %02d:%02d.%03d",
+			p.Name, p.World.Score, label, minutes, seconds, milliseconds)
+		b.WriteString(centerText(result, m.Width))
+		b.WriteString("\n")
+	}
+
+	// Display seed so the match can be replayed with --seed
+	seed := fmt.Sprintf("Seed: %d  |  %s  |  Hitbox: %s", m.World.Seed, m.World.Difficulty, m.World.Hitbox)
+	b.WriteString("\n")
+	b.WriteString(centerText(seed, m.Width))
+	b.WriteString("\n\n")
+
+	instructions := "Press SPACE or R for a rematch  |  ESC for title  |  Press Q to quit"
+	if m.Net != nil {
+		instructions = "Press Q to quit"
+	} else if m.Editor != nil {
+		instructions = "SPACE or R to play-test again  |  ESC for editor  |  Q to quit"
+	}
+	b.WriteString(centerText(instructions, m.Width))
+
+	return b.String()
+}
@@ -11,11 +11,10 @@ import (
 )
 
 const (
-	birdChar         = "●" // Round bird - more visible
-	pipeBodyChar     = "▓" // Pipe body - dark pattern block
-	pipeEdgeChar     = "█" // Pipe edge - full block
-	titlePadding     = 16  // Vertical padding for title screen ASCII art
-	gameOverPadding  = 12  // Vertical padding for game over screen ASCII art
+	birdChar     = "●" // Round bird - more visible
+	pipeBodyChar = "▓" // Pipe body - dark pattern block
+	pipeEdgeChar = "█" // Pipe edge - full block
+	finishChar   = "▚" // Course finish line - checkered flag
 )
 
 // formatDuration formats a duration as MM:SS.mmm
@@ -35,10 +34,29 @@ func View(m game.Model) string {
 	switch m.State {
 	case game.StateTitle:
 		return renderTitle(m)
-	case game.StatePlaying:
+	case game.StatePlaying, game.StatePractice:
+		if m.Players != nil {
+			return renderVersus(m)
+		}
 		return renderGame(m)
 	case game.StateGameOver:
+		if m.Players != nil {
+			return renderVersusResult(m)
+		}
+		if m.World.Cleared {
+			return renderCourseClear(m)
+		}
 		return renderGameOver(m)
+	case game.StateDailyBoard:
+		return renderDailyBoard(m)
+	case game.StateEditor:
+		return renderEditor(m)
+	case game.StateShop:
+		return renderShop(m)
+	case game.StateTournamentSetup:
+		return renderTournamentSetup(m)
+	case game.StateTournament:
+		return renderTournament(m)
 	}
 
 	return ""
@@ -99,14 +117,6 @@ func renderTitle(m game.Model) string {
 
 	instructions := "Press SPACE to start  |  Press Q to quit"
 
-	padding := (m.Height - titlePadding) / 2
-	if padding < 0 {
-		padding = 0
-	}
-	for i := 0; i < padding; i++ {
-		b.WriteString("\n")
-	}
-
 	// Get theme styles
 	titleStyle, scoreStyle, _, _ := getStyles(m)
 
@@ -120,98 +130,312 @@ func renderTitle(m game.Model) string {
 	b.WriteString("\n")
 	b.WriteString(centerText(subtitle, m.Width))
 	b.WriteString("\n\n")
+
+	// Display the settings, two to a line so the screen fits in 24 rows
 	b.WriteString(centerText(difficultyText, m.Width))
 	b.WriteString("\n")
-
-	// Display theme selection
-	themeText := fmt.Sprintf("Theme: %s (Press T to change)", m.Theme.String())
-	b.WriteString(centerText(themeText, m.Width))
+	settingsText := fmt.Sprintf("Mode: %s [M]  |  Hitbox: %s [H]", m.Mode.String(), m.Hitbox.String())
+	b.WriteString(centerText(settingsText, m.Width))
 	b.WriteString("\n")
+	looksText := fmt.Sprintf("%s  |  Theme: %s [T]", powerUpsText(m.PowerUps), m.Theme.String())
+	b.WriteString(centerText(looksText, m.Width))
+	b.WriteString("\n")
+
+	// Display speedrun target selection
+	if m.Mode == domain.ModeSpeedrun {
+		targetText := fmt.Sprintf("Target: %d pipes [N]", m.Target)
+		b.WriteString(centerText(targetText, m.Width))
+		b.WriteString("\n")
+	}
+
+	// Display the keys of the players sharing the screen
+	if m.Mode == domain.ModeVersus {
+		b.WriteString(centerText(versusKeysText(m), m.Width))
+		b.WriteString("\n")
+	}
+
+	// Display the course loaded with --course
+	if m.Course != nil {
+		courseText := fmt.Sprintf("Course: %s (%d pipes)", courseName(m.Course), len(m.Course.Pipes))
+		b.WriteString(centerText(courseText, m.Width))
+		b.WriteString("\n")
+	} else if m.FixedSeed != 0 {
+		// Display fixed seed when one was given with --seed
+		seedText := fmt.Sprintf("Seed: %d", m.FixedSeed)
+		b.WriteString(centerText(seedText, m.Width))
+		b.WriteString("\n")
+	}
 
+	b.WriteString("\n")
 	b.WriteString(centerText(instructions, m.Width))
+	b.WriteString("\n")
 
-	// Display high score if it exists
+	// Display the daily challenge, shop and tournament entries
+	menuText := "D: Daily challenge  |  L: Daily results  |  S: Shop  |  O: Tournament"
+	b.WriteString(centerText(menuText, m.Width))
+	b.WriteString("\n")
+
+	// Display the coin balance, after the high score if it exists
+	statusText := fmt.Sprintf("%c %d coins", coinChar, m.Wallet.Coins)
 	if m.HighScore.Score > 0 {
-		b.WriteString("\n\n")
 		minutes, seconds, milliseconds := formatDuration(m.HighScore.Duration)
 		highScoreText := fmt.Sprintf("High Score: %d  |  Time: %02d:%02d.%03d",
 			m.HighScore.Score, minutes, seconds, milliseconds)
-		b.WriteString(centerText(scoreStyle.Render(highScoreText), m.Width))
+		statusText = scoreStyle.Render(highScoreText) + "  |  " + statusText
 	}
+	b.WriteString(centerText(statusText, m.Width))
 
-	return b.String()
+	if m.Notice != "" {
+		b.WriteString("\n")
+		b.WriteString(centerText(m.Notice, m.Width))
+	}
+
+	// Center the screen vertically on its rendered height
+	screen := b.String()
+	padding := max((m.Height-lipgloss.Height(screen))/2, 0)
+	return strings.Repeat("\n", padding) + screen
 }
 
 func renderGame(m game.Model) string {
 	// Get theme styles
 	_, scoreStyle, _, _ := getStyles(m)
 
-	// Create empty canvas
-	canvas := make([][]rune, m.Height)
-	for i := range canvas {
-		canvas[i] = make([]rune, m.Width)
-		for j := range canvas[i] {
-			canvas[i][j] = ' '
+	// Create empty canvas, clipped to the terminal if it shrank during the run
+	world := m.World
+	width := min(m.Width, world.Width)
+	height := min(m.Height, world.Height)
+	canvas := newCanvas(width, height)
+
+	// Draw the playfield
+	drawWorld(canvas, m, world)
+
+	// Ghost of the personal best, drawn faintly on empty cells only
+	ghostY, ghostX := -1, -1
+	var ghostSprite string
+	if m.Ghost != nil && !m.Ghost.World.Over {
+		ghost := m.Ghost.World.Bird
+		ghostY = ghost.Row(m.Ghost.World.Hitbox)
+		ghostX = ghost.X
+		ghostSprite = m.Sprite.Glyphs(ghost.Velocity)
+	}
+	ghostStyle := lipgloss.NewStyle().Faint(true).Foreground(m.Theme.GetColors().Ghost)
+
+	// Convert canvas to string
+	var b strings.Builder
+	for y, row := range canvas {
+		if y == ghostY && ghostX >= 0 && ghostX+1 < width && row[ghostX] == ' ' && row[ghostX+1] == ' ' {
+			b.WriteString(string(row[:ghostX]))
+			b.WriteString(ghostStyle.Render(ghostSprite))
+			b.WriteString(string(row[ghostX+2:]))
+		} else {
+			b.WriteString(string(row))
 		}
+		b.WriteString("\n")
 	}
 
-	// Draw pipes (▢▢▢▢ with ■■■■ edge)
-	for _, pipe := range m.Pipes {
-		for x := pipe.X; x < pipe.X+domain.PipeWidth && x < m.Width; x++ {
-			if x < 0 {
-				continue
-			}
+	// Add score and elapsed time
+	// Timed modes count down instead of up
+	timeLabel := "Time"
+	elapsed := world.Elapsed()
+	if world.Mode.TimeLimit() > 0 {
+		timeLabel = "Time Left"
+		elapsed = world.TimeLeft()
+	}
+	minutes, seconds, milliseconds := formatDuration(elapsed)
 
-			// Top pipe - body is ▢, bottom edge is ■
-			for y := 0; y < pipe.GapY; y++ {
-				char := pipeBodyChar
-				if y == pipe.GapY-1 {
-					// Bottom edge of top pipe
-					char = pipeEdgeChar
-				}
-				canvas[y][x] = []rune(char)[0]
-			}
+	scoreText := fmt.Sprintf("Score: %d", world.Score)
+	if world.Target > 0 {
+		scoreText = fmt.Sprintf("Pipes: %d/%d", world.Score, world.Target)
+	}
+	score := scoreStyle.Render(scoreText)
+	timeDisplay := scoreStyle.Render(fmt.Sprintf("%s: %02d:%02d.%03d", timeLabel, minutes, seconds, milliseconds))
 
-			// Bottom pipe - body is ▢, top edge is ■
-			for y := pipe.GapY + pipe.GapSize; y < m.Height; y++ {
-				char := pipeBodyChar
-				if y == pipe.GapY+pipe.GapSize {
-					// Top edge of bottom pipe
-					char = pipeEdgeChar
-				}
-				canvas[y][x] = []rune(char)[0]
-			}
+	// Zen mode keeps score and time hidden until the run ends
+	if world.Mode == domain.ModeZen {
+		b.WriteString(scoreStyle.Render("ZEN  [Esc] end run"))
+	} else {
+		b.WriteString(score)
+		b.WriteString("  ")
+		b.WriteString(timeDisplay)
+	}
+
+	// Show the coins picked up so far
+	if world.CoinsCollected > 0 {
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render(fmt.Sprintf("%c %d", coinChar, world.CoinsCollected)))
+	}
+
+	// Show the zone the bird is flying through
+	if zone := world.ZoneAt(); zone != nil {
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render("Zone: " + zone.Kind.String()))
+	}
+
+	// Show the active power-up and how long it lasts
+	if power := renderPowerUp(world); power != "" {
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render(power))
+	}
+
+	// Show the cost of collisions in time attack
+	if world.Mode == domain.ModeTimeAttack {
+		penalty := fmt.Sprintf("Crashes: %d (-%ds each)", world.Crashes, int(world.Mode.CrashPenalty().Seconds()))
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render(penalty))
+	}
+
+	// Show the latest speedrun split against the best splits
+	if split := renderLastSplit(m); split != "" {
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render(split))
+	}
+
+	// Show the live score difference against the ghost
+	if m.Ghost != nil {
+		ghostText := fmt.Sprintf("Ghost: %+d (best %d)", m.ScoreDiff(), m.Ghost.Best.Score)
+		b.WriteString("  ")
+		b.WriteString(ghostStyle.Render(ghostText))
+	}
+
+	// Show whose turn it is in a tournament
+	if t := m.Tournament; t != nil {
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render(fmt.Sprintf("%s's turn  Round %d/%d", t.NextPlayer(), t.Round(), t.Rounds)))
+	}
+
+	// Show playback controls when watching a replay
+	if m.Playback != nil {
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render(renderPlaybackStatus(m)))
+	}
+
+	// Show practice controls
+	if m.State == game.StatePractice {
+		invincible := "off"
+		if world.Invincible {
+			invincible = "on"
 		}
+		practice := fmt.Sprintf("PRACTICE  Crashes: %d  [B] rewind  [I] invincible: %s  [Esc] quit",
+			world.Crashes, invincible)
+		if m.Held {
+			practice = "PRACTICE  Press SPACE to continue, B to go further back"
+		}
+		b.WriteString("  ")
+		b.WriteString(scoreStyle.Render(practice))
+	}
+
+	return b.String()
+}
+
+// drawWorld draws everything in a world onto the canvas, clipped to the canvas
+func drawWorld(canvas [][]rune, m game.Model, world *domain.World) {
+	if len(canvas) == 0 {
+		return
+	}
+	height, width := len(canvas), len(canvas[0])
+
+	// Mark physics zones underneath everything else
+	drawZones(canvas, world.Zones)
+
+	// Draw pipes
+	for _, pipe := range world.Pipes {
+		drawPipe(canvas, pipe)
+	}
+
+	// Draw moving hazards
+	drawHazards(canvas, world.Hazards)
+
+	// Draw power-up items waiting in the gaps and coins on the way
+	drawItems(canvas, world.Pipes)
+	drawCoins(canvas, world.Coins)
+
+	// Draw the course's finish line once it scrolls into view
+	if world.Course != nil {
+		drawFinish(canvas, world.FinishX())
 	}
 
 	// Draw bird (2 characters: mO or wO)
-	birdY := m.Bird.GetY()
-	birdSprite := m.Bird.GetSprite()
-	if birdY >= 0 && birdY < m.Height && m.Bird.X >= 0 && m.Bird.X+1 < m.Width {
+	bird := world.Bird
+	birdY := bird.Row(world.Hitbox)
+	birdSprite := m.Sprite.Glyphs(bird.Velocity)
+	if birdY >= 0 && birdY < height && bird.X >= 0 && bird.X+1 < width {
 		spriteRunes := []rune(birdSprite)
-		canvas[birdY][m.Bird.X] = spriteRunes[0]   // First character (m or w)
-		canvas[birdY][m.Bird.X+1] = spriteRunes[1] // Second character (O)
+		if !world.Shrunk() {
+			canvas[birdY][bird.X] = spriteRunes[0] // First character (m or w)
+		}
+		canvas[birdY][bird.X+1] = spriteRunes[1] // Second character (O), all that's left when shrunk
 	}
+}
 
-	// Convert canvas to string
-	var b strings.Builder
-	for _, row := range canvas {
-		b.WriteString(string(row))
-		b.WriteString("\n")
+// newCanvas creates an empty canvas
+func newCanvas(width, height int) [][]rune {
+	canvas := make([][]rune, height)
+	for i := range canvas {
+		canvas[i] = make([]rune, width)
+		for j := range canvas[i] {
+			canvas[i][j] = ' '
+		}
+	}
+	return canvas
+}
+
+// drawPipe draws a pipe onto the canvas (▢▢▢▢ with ■■■■ edge), clipped to the canvas
+func drawPipe(canvas [][]rune, pipe *domain.Pipe) {
+	height := len(canvas)
+	if height == 0 {
+		return
 	}
+	width := len(canvas[0])
 
-	// Add score and elapsed time
-	elapsed := time.Since(m.StartTime)
-	minutes, seconds, milliseconds := formatDuration(elapsed)
+	for x := pipe.X; x < pipe.X+pipe.Width && x < width; x++ {
+		if x < 0 {
+			continue
+		}
 
-	score := scoreStyle.Render(fmt.Sprintf("Score: %d", m.Score))
-	timeDisplay := scoreStyle.Render(fmt.Sprintf("Time: %02d:%02d.%03d", minutes, seconds, milliseconds))
+		// Top pipe - body is ▢, bottom edge is ■
+		for y := 0; y < pipe.GapY && y < height; y++ {
+			char := pipeBodyChar
+			if y == pipe.GapY-1 {
+				// Bottom edge of top pipe
+				char = pipeEdgeChar
+			}
+			canvas[y][x] = []rune(char)[0]
+		}
 
-	b.WriteString(score)
-	b.WriteString("  ")
-	b.WriteString(timeDisplay)
+		// Bottom pipe - body is ▢, top edge is ■
+		for y := pipe.GapY + pipe.GapSize; y < height; y++ {
+			char := pipeBodyChar
+			if y == pipe.GapY+pipe.GapSize {
+				// Top edge of bottom pipe
+				char = pipeEdgeChar
+			}
+			canvas[y][x] = []rune(char)[0]
+		}
+	}
+}
 
-	return b.String()
+// drawHazards draws every hazard that is in view onto the canvas
+func drawHazards(canvas [][]rune, hazards []domain.Hazard) {
+	for _, h := range hazards {
+		x, y, glyph := h.Glyph()
+		if y < 0 || y >= len(canvas) {
+			continue
+		}
+		for i, r := range []rune(glyph) {
+			if x+i >= 0 && x+i < len(canvas[y]) {
+				canvas[y][x+i] = r
+			}
+		}
+	}
+}
+
+// drawFinish draws a course's finish line onto the canvas if it is in view
+func drawFinish(canvas [][]rune, x int) {
+	for y := range canvas {
+		if x >= 0 && x < len(canvas[y]) {
+			canvas[y][x] = []rune(finishChar)[0]
+		}
+	}
 }
 
 func renderGameOver(m game.Model) string {
@@ -228,22 +452,13 @@ func renderGameOver(m game.Model) string {
 ╚██████╔╝██║  ██║██║ ╚═╝ ██║███████╗    ╚██████╔╝ ╚████╔╝ ███████╗██║  ██║
  ╚═════╝ ╚═╝  ╚═╝╚═╝     ╚═╝╚══════╝     ╚═════╝   ╚═══╝  ╚══════╝╚═╝  ╚═╝`
 
-	padding := (m.Height - gameOverPadding) / 2
-	if padding < 0 {
-		padding = 0
-	}
-	for i := 0; i < padding; i++ {
-		b.WriteString("\n")
-	}
-
 	// Display ASCII art
 	gameOverArt := gameOverStyle.Render(asciiArt)
 	b.WriteString(centerText(gameOverArt, m.Width))
 	b.WriteString("\n\n")
 
 	// Calculate elapsed time
-	elapsed := time.Since(m.StartTime)
-	minutes, seconds, milliseconds := formatDuration(elapsed)
+	minutes, seconds, milliseconds := formatDuration(m.World.Elapsed())
 
 	// Display new record message if applicable
 	if m.IsNewRecord {
@@ -253,40 +468,117 @@ func renderGameOver(m game.Model) string {
 	}
 
 	// Display score and time
-	score := fmt.Sprintf("Score: %d  |  Time: %02d:%02d.%03d", m.Score, minutes, seconds, milliseconds)
+	score := fmt.Sprintf("Score: %d  |  Time: %02d:%02d.%03d", m.World.Score, minutes, seconds, milliseconds)
 	b.WriteString(centerText(score, m.Width))
 	b.WriteString("\n")
 
 	// Display statistics
 	stats := fmt.Sprintf("Jumps: %d  |  Max Height: %d  |  Avg: %.1f", m.Stats.JumpCount, m.Stats.MaxHeight, m.AvgHeight())
 	b.WriteString(centerText(stats, m.Width))
+	b.WriteString("\n")
+
+	// Display coins earned, which replays and play-tests don't pay out
+	if m.World.CoinsCollected > 0 && m.Playback == nil && m.Editor == nil {
+		coins := fmt.Sprintf("Coins: +%d  |  Balance: %c %d", m.World.CoinsCollected, coinChar, m.Wallet.Coins)
+		b.WriteString(centerText(coins, m.Width))
+		b.WriteString("\n")
+	}
+
+	// Display mode result
+	if m.World.Mode == domain.ModeTimeAttack {
+		timeUp := fmt.Sprintf("Time up!  |  Crashes: %d", m.World.Crashes)
+		b.WriteString(centerText(timeUp, m.Width))
+		b.WriteString("\n")
+	}
+	if m.World.Target > 0 {
+		finished := fmt.Sprintf("Target reached!  |  Crashes: %d", m.World.Crashes)
+		b.WriteString(centerText(finished, m.Width))
+		b.WriteString("\n")
+		// Only the final split fits on the screen, against the best run's
+		if split := renderLastSplit(m); split != "" {
+			b.WriteString(centerText(split, m.Width))
+			b.WriteString("\n")
+		}
+	}
+
+	// Display seed so the run can be repeated with --seed
+	seed := fmt.Sprintf("Seed: %d  |  Hitbox: %s", m.World.Seed, m.World.Hitbox.String())
+	b.WriteString(centerText(seed, m.Width))
 	b.WriteString("\n\n")
 
-	// Display rankings (top 5 for game over screen)
-	if len(m.Rankings) > 0 {
-		b.WriteString(centerText("=== TOP RANKINGS ===", m.Width))
+	// Display today's daily board instead of the rankings after a daily run
+	if m.DailyDate != "" {
+		dailyTitle := fmt.Sprintf("=== DAILY %s ===", m.DailyDate)
+		b.WriteString(centerText(dailyTitle, m.Width))
+		b.WriteString("\n")
+		if !m.Counted {
+			b.WriteString(centerText("(Not counted - only your first run of the day counts)", m.Width))
+			b.WriteString("\n")
+		}
+		b.WriteString(renderDailyResults(m.DailyBoards[m.DailyDate], 5, m.Store.Shared(), m.Width))
+		b.WriteString("\n")
+	} else if rankings := m.Leaderboard(); len(rankings) > 0 {
+		// Display rankings (top 5 for game over screen)
+		rankingsTitle := "=== TOP RANKINGS ==="
+		if m.LeaderboardName() != domain.ModeClassic.String() {
+			rankingsTitle = fmt.Sprintf("=== %s RANKINGS ===", strings.ToUpper(m.LeaderboardName()))
+		}
+		b.WriteString(centerText(rankingsTitle, m.Width))
 		b.WriteString("\n")
 		displayCount := 5
-		if len(m.Rankings) < displayCount {
-			displayCount = len(m.Rankings)
+		if len(rankings) < displayCount {
+			displayCount = len(rankings)
 		}
 		for i := 0; i < displayCount; i++ {
-			rank := m.Rankings[i]
+			rank := rankings[i]
 			rankMin := int(rank.Duration.Minutes())
 			rankSec := int(rank.Duration.Seconds()) % 60
 			rankMs := int(rank.Duration.Milliseconds()) % 1000
-			rankText := fmt.Sprintf("%d. %d pts  %02d:%02d.%03d  [%s]",
-				i+1, rank.Score, rankMin, rankSec, rankMs, rank.Difficulty)
+			place := fmt.Sprintf("%d.", i+1)
+			if m.Store.Shared() && rank.Player != "" {
+				// Everyone on the server shares the rankings, so show who set each score
+				place += " " + rank.Player + " "
+			}
+			rankText := fmt.Sprintf("%s %d pts  %02d:%02d.%03d  [%s]",
+				place, rank.Score, rankMin, rankSec, rankMs, rank.Difficulty)
+			if rank.Hitbox != "" {
+				rankText = fmt.Sprintf("%s %d pts  %02d:%02d.%03d  [%s/%s]",
+					place, rank.Score, rankMin, rankSec, rankMs, rank.Difficulty, rank.Hitbox)
+			}
+			if m.Mode == domain.ModeSpeedrun {
+				// Time is the score in a speedrun
+				rankText = fmt.Sprintf("%s %02d:%02d.%03d  [%s]", place, rankMin, rankSec, rankMs, rank.Hitbox)
+			}
 			b.WriteString(centerText(rankText, m.Width))
 			b.WriteString("\n")
 		}
 		b.WriteString("\n")
 	}
 
-	instructions := "Press SPACE or R to restart  |  Press Q to quit"
+	instructions := "Press SPACE or R to restart  |  ESC for title  |  Press Q to quit"
+	if m.Playback != nil {
+		instructions = "Replay finished  |  Press R to watch again  |  Press Q to quit"
+	} else if m.DailyDate != "" {
+		instructions = "SPACE or R to try again  |  ESC for title  |  Q to quit"
+	} else if m.Editor != nil {
+		instructions = "SPACE or R to play-test again  |  ESC for editor  |  Q to quit"
+	}
 	b.WriteString(centerText(instructions, m.Width))
 
-	return b.String()
+	// Center the screen vertically on its rendered height
+	screen := b.String()
+	padding := max((m.Height-lipgloss.Height(screen))/2, 0)
+	return strings.Repeat("\n", padding) + screen
+}
+
+// renderPlaybackStatus describes the replay position, speed and controls
+func renderPlaybackStatus(m game.Model) string {
+	status := "▶"
+	if m.Playback.Paused {
+		status = "❚❚"
+	}
+	return fmt.Sprintf("REPLAY %s %gx  Step %d/%d  [Space] pause  [→] step  [+/-] speed",
+		status, m.Playback.Speed(), m.World.Tick, m.Playback.Replay.Ticks)
 }
 
 func centerText(text string, width int) string {
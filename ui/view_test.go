@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/takish/flappy-bird-tui/domain"
+	"github.com/takish/flappy-bird-tui/game"
+	"github.com/takish/flappy-bird-tui/storage"
+)
+
+func TestTitleFitsTerminal(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(m *game.Model)
+	}{
+		{name: "first start", setup: func(m *game.Model) {}},
+		{name: "versus with a seed", setup: func(m *game.Model) {
+			m.Mode = domain.ModeVersus
+			m.FixedSeed = 42
+		}},
+		{name: "speedrun on a course", setup: func(m *game.Model) {
+			m.Mode = domain.ModeSpeedrun
+			m.Course = &domain.Course{Name: "Gauntlet", Pipes: make([]domain.CoursePipe, 12)}
+		}},
+	}
+
+	for _, tt := range tests {
+		for _, size := range [][2]int{{80, 24}, {120, 40}} {
+			t.Run(fmt.Sprintf("%s at %dx%d", tt.name, size[0], size[1]), func(t *testing.T) {
+				m := game.NewModel(game.Options{Store: &storage.Store{Dir: t.TempDir(), Player: "tester"}, Quiet: true})
+				m.Width, m.Height = size[0], size[1]
+				m.HighScore = &storage.HighScore{Score: 123, Duration: 95 * time.Second}
+				m.Notice = "Bought the Ocean theme"
+				m.PowerUps = true
+				tt.setup(&m)
+
+				screen := View(m)
+				if h := lipgloss.Height(screen); h > m.Height {
+					t.Errorf("%dx%d: title is %d rows tall", m.Width, m.Height, h)
+				}
+				if w := lipgloss.Width(screen); w > m.Width {
+					t.Errorf("%dx%d: title is %d columns wide", m.Width, m.Height, w)
+				}
+
+				// The screen is centered vertically
+				above := len(screen) - len(strings.TrimLeft(screen, "\n"))
+				if below := m.Height - lipgloss.Height(screen); above < below-1 || above > below {
+					t.Errorf("%dx%d: %d rows above the title and %d below", m.Width, m.Height, above, below)
+				}
+			})
+		}
+	}
+}
+
+func TestGameOverFitsTerminal(t *testing.T) {
+	ranks := make([]storage.HighScore, 10)
+	for i := range ranks {
+		ranks[i] = storage.HighScore{Score: 100, Duration: time.Duration(90+i) * time.Second, Difficulty: "Normal", Hitbox: "Precise"}
+	}
+	splits := make([]time.Duration, 10)
+	for i := range splits {
+		splits[i] = time.Duration(i+1) * 9 * time.Second
+	}
+
+	tests := []struct {
+		name  string
+		setup func(m *game.Model)
+	}{
+		{name: "classic", setup: func(m *game.Model) {
+			m.Rankings = ranks
+		}},
+		{name: "100 pipe speedrun", setup: func(m *game.Model) {
+			m.Mode = domain.ModeSpeedrun
+			m.World.Target = 100
+			m.World.Score = 100
+			m.World.Splits = splits
+			m.PBSplits = splits
+			m.ModeRankings[m.LeaderboardName()] = ranks
+		}},
+	}
+
+	for _, tt := range tests {
+		for _, size := range [][2]int{{80, 24}, {120, 40}} {
+			t.Run(fmt.Sprintf("%s at %dx%d", tt.name, size[0], size[1]), func(t *testing.T) {
+				m := game.NewModel(game.Options{Store: &storage.Store{Dir: t.TempDir(), Player: "tester"}, Quiet: true})
+				m.Width, m.Height = size[0], size[1]
+				m.World = domain.NewWorld(domain.Config{Width: m.Width, Height: m.Height, Seed: 1})
+				m.World.CoinsCollected = 3
+				m.IsNewRecord = true
+				tt.setup(&m)
+				m.State = game.StateGameOver
+
+				screen := View(m)
+				if h := lipgloss.Height(screen); h > m.Height {
+					t.Errorf("%dx%d: game over screen is %d rows tall", m.Width, m.Height, h)
+				}
+				if w := lipgloss.Width(screen); w > m.Width {
+					t.Errorf("%dx%d: game over screen is %d columns wide", m.Width, m.Height, w)
+				}
+			})
+		}
+	}
+}
+
+func TestVersusFitsTerminal(t *testing.T) {
+	for _, size := range [][2]int{{80, 24}, {120, 40}} {
+		t.Run(fmt.Sprintf("%dx%d", size[0], size[1]), func(t *testing.T) {
+			m := game.NewModel(game.Options{Store: &storage.Store{Dir: t.TempDir(), Player: "tester"}, Quiet: true})
+			m.Width, m.Height = size[0], size[1]
+			m.Mode = domain.ModeVersus
+			m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
+			if m.Players == nil {
+				t.Fatal("versus match didn't start")
+			}
+
+			// Every row of each playfield is drawn, with the status lines below them
+			screen := View(m)
+			if h := lipgloss.Height(screen); h != m.Height {
+				t.Errorf("versus screen is %d rows tall, want %d", h, m.Height)
+			}
+			for _, p := range m.Players {
+				if p.World.Height != m.Height-1 {
+					t.Errorf("%s's playfield is %d rows tall, want %d", p.Name, p.World.Height, m.Height-1)
+				}
+			}
+		})
+	}
+}
+
+func TestRankingsTitleNamesBoard(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     domain.Mode
+		powerUps bool
+		want     string
+	}{
+		{name: "classic", mode: domain.ModeClassic, want: "=== TOP RANKINGS ==="},
+		{name: "classic with power-ups", mode: domain.ModeClassic, powerUps: true, want: "=== CLASSIC POWER-UPS RANKINGS ==="},
+		{name: "time attack", mode: domain.ModeTimeAttack, want: "=== TIME ATTACK RANKINGS ==="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := game.NewModel(game.Options{Store: &storage.Store{Dir: t.TempDir(), Player: "tester"}, Quiet: true})
+			m.Width, m.Height = 80, 24
+			m.World = domain.NewWorld(domain.Config{Width: m.Width, Height: m.Height, Seed: 1})
+			m.Mode, m.PowerUps = tt.mode, tt.powerUps
+			board := []storage.HighScore{{Score: 10, Duration: time.Minute, Difficulty: "Normal"}}
+			m.Rankings = board
+			m.ModeRankings[m.LeaderboardName()] = board
+			m.State = game.StateGameOver
+
+			if screen := View(m); !strings.Contains(screen, tt.want) {
+				t.Errorf("game over screen doesn't show %q:\n%s", tt.want, screen)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package ui
+
+import "github.com/takish/flappy-bird-tui/domain"
+
+const (
+	zoneEdgeChar  = '┊' // Boundary of a zone
+	zoneMarkStepX = 6   // Columns between zone markers
+	zoneMarkStepY = 4   // Rows between zone markers
+)
+
+// zoneMarks are the glyphs scattered over each kind of zone
+var zoneMarks = map[domain.ZoneKind]rune{
+	domain.ZoneHeadwind:       '‹',
+	domain.ZoneTailwind:       '›',
+	domain.ZoneLowGravity:     '·',
+	domain.ZoneHeavyGravity:   '↓',
+	domain.ZoneReverseGravity: '↑',
+}
+
+// drawZones marks the zones in view with their edges and a sparse pattern, under everything else
+func drawZones(canvas [][]rune, zones []domain.Zone) {
+	for _, z := range zones {
+		for y := range canvas {
+			for x := max(z.X, 0); x < z.X+z.Width && x < len(canvas[y]); x++ {
+				switch {
+				case x == z.X || x == z.X+z.Width-1:
+					canvas[y][x] = zoneEdgeChar
+				case (x-z.X)%zoneMarkStepX == zoneMarkStepX/2 && y%zoneMarkStepY == zoneMarkStepY/2:
+					canvas[y][x] = zoneMarks[z.Kind]
+				}
+			}
+		}
+	}
+}